@@ -0,0 +1,231 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+)
+
+var (
+	errUsage         = errors.New("usage error")
+	errEmptyResponse = errors.New("empty response")
+
+	_accountCommands = map[string]func(form3.IClient, []string, output, io.Writer) error{
+		"create":    createAccount,
+		"fetch":     fetchAccount,
+		"delete":    deleteAccount,
+		"list":      listAccounts,
+		"import":    importAccounts,
+		"export":    exportAccounts,
+		"reconcile": reconcileAccounts,
+	}
+)
+
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func createAccount(client form3.IClient, args []string, out output, stderr io.Writer) error {
+	var (
+		file                    string
+		id                      string
+		organisationID          string
+		accountType             string
+		accountClassification   string
+		accountNumber           string
+		alternativeNames        stringList
+		bankID                  string
+		bankIDCode              string
+		baseCurrency            string
+		bic                     string
+		country                 string
+		iban                    string
+		name                    stringList
+		secondaryIdentification string
+		status                  string
+		accountMatchingOptOut   bool
+		jointAccount            bool
+		switched                bool
+	)
+
+	flags := newFlagSet("accounts create", stderr)
+	flags.StringVar(&file, "f", "", "json or yaml file with the account, flags override its values")
+	flags.StringVar(&id, "id", "", "account id")
+	flags.StringVar(&organisationID, "organisation-id", "", "organisation id")
+	flags.StringVar(&accountType, "type", "accounts", "resource type")
+	flags.StringVar(&accountClassification, "account-classification", "", "account classification")
+	flags.StringVar(&accountNumber, "account-number", "", "account number")
+	flags.Var(&alternativeNames, "alternative-name", "alternative name, can be repeated")
+	flags.StringVar(&bankID, "bank-id", "", "bank id")
+	flags.StringVar(&bankIDCode, "bank-id-code", "", "bank id code")
+	flags.StringVar(&baseCurrency, "base-currency", "", "base currency")
+	flags.StringVar(&bic, "bic", "", "bic")
+	flags.StringVar(&country, "country", "", "country")
+	flags.StringVar(&iban, "iban", "", "iban")
+	flags.Var(&name, "name", "account name, can be repeated")
+	flags.StringVar(&secondaryIdentification, "secondary-identification", "", "secondary identification")
+	flags.StringVar(&status, "status", "", "status")
+	flags.BoolVar(&accountMatchingOptOut, "account-matching-opt-out", false, "account matching opt out")
+	flags.BoolVar(&jointAccount, "joint-account", false, "joint account")
+	flags.BoolVar(&switched, "switched", false, "switched")
+
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	account := models.Account{}
+	if file != "" {
+		var err error
+		if account, err = readAccountFile(file); err != nil {
+			return err
+		}
+	}
+
+	if account.Data == nil {
+		account.WithData(models.AccountData{})
+	}
+	data := account.Data
+
+	if data.Attributes == nil {
+		data.WithAttributes(models.AccountAttributes{})
+	}
+	attributes := data.Attributes
+
+	flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "id":
+			data.WithID(id)
+		case "organisation-id":
+			data.WithOrganisationID(organisationID)
+		case "account-classification":
+			attributes.WithAccountClassification(accountClassification)
+		case "account-number":
+			attributes.WithAccountNumber(accountNumber)
+		case "alternative-name":
+			attributes.WithAlternativeNames(alternativeNames)
+		case "bank-id":
+			attributes.WithBankID(bankID)
+		case "bank-id-code":
+			attributes.WithBankIDCode(bankIDCode)
+		case "base-currency":
+			attributes.WithBaseCurrency(baseCurrency)
+		case "bic":
+			attributes.WithBic(bic)
+		case "country":
+			attributes.WithCountry(country)
+		case "iban":
+			attributes.WithIban(iban)
+		case "name":
+			attributes.WithName(name)
+		case "secondary-identification":
+			attributes.WithSecondaryIdentification(secondaryIdentification)
+		case "status":
+			attributes.WithStatus(status)
+		case "account-matching-opt-out":
+			attributes.WithAccountMatchingOptOut(accountMatchingOptOut)
+		case "joint-account":
+			attributes.WithJointAccount(jointAccount)
+		case "switched":
+			attributes.WithSwitched(switched)
+		}
+	})
+
+	if data.Type == "" {
+		data.WithType(accountType)
+	}
+
+	created, err := client.CreateAccount(account)
+	if err != nil {
+		return err
+	}
+
+	if created.Data == nil {
+		return fmt.Errorf("%w: created account without data", errEmptyResponse)
+	}
+
+	return out.writeAccount(*created.Data)
+}
+
+func fetchAccount(client form3.IClient, args []string, out output, stderr io.Writer) error {
+	var id string
+
+	flags := newFlagSet("accounts fetch", stderr)
+	flags.StringVar(&id, "id", "", "account id")
+
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	account, err := client.FetchAccount(id)
+	if err != nil {
+		return err
+	}
+
+	if account.Data == nil {
+		return fmt.Errorf("%w: fetched account without data", errEmptyResponse)
+	}
+
+	return out.writeAccount(*account.Data)
+}
+
+func deleteAccount(client form3.IClient, args []string, _ output, stderr io.Writer) error {
+	var (
+		id      string
+		version int64
+	)
+
+	flags := newFlagSet("accounts delete", stderr)
+	flags.StringVar(&id, "id", "", "account id")
+	flags.Int64Var(&version, "version", 0, "account version")
+
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	return client.DeleteAccount(id, version)
+}
+
+func listAccounts(client form3.IClient, args []string, out output, stderr io.Writer) error {
+	var (
+		pageNumber int
+		pageSize   int
+	)
+
+	flags := newFlagSet("accounts list", stderr)
+	flags.IntVar(&pageNumber, "page", 0, "page number")
+	flags.IntVar(&pageSize, "page-size", 100, "page size")
+
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	list, err := client.ListAccounts(pageNumber, pageSize)
+	if err != nil {
+		return err
+	}
+
+	return out.writeAccounts(list.Data)
+}
+
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: form3ctl %s [flags]\n\nflags:\n", name)
+		flags.PrintDefaults()
+	}
+
+	return flags
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3"
+	"github.com/francorosatti/form3-api-client/pkg/form3/bulk"
+)
+
+func exportAccounts(client form3.IClient, args []string, out output, stderr io.Writer) error {
+	var (
+		file           string
+		format         string
+		fields         string
+		organisationID string
+		pageSize       int
+		mask           bool
+	)
+
+	flags := newFlagSet("accounts export", stderr)
+	flags.StringVar(&file, "f", "", "output file, defaults to stdout")
+	flags.StringVar(&format, "format", string(bulk.FormatCSV), "output format: csv, ndjson or columnar")
+	flags.StringVar(&fields, "fields", "", "comma separated list of exported fields, defaults to all")
+	flags.StringVar(&organisationID, "organisation-id", "", "export only the accounts of this organisation")
+	flags.IntVar(&pageSize, "page-size", 100, "number of accounts requested per page")
+	flags.BoolVar(&mask, "mask", false, "mask sensitive attributes like account numbers and names")
+
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	selectedFields, err := bulk.ParseFields(fields)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return errUsage
+	}
+
+	opts := []bulk.ExporterOption{
+		bulk.WithFormat(bulk.Format(format)),
+		bulk.WithFields(selectedFields),
+		bulk.WithOrganisationID(organisationID),
+		bulk.WithPageSize(pageSize),
+	}
+	if mask {
+		opts = append(opts, bulk.WithMasking())
+	}
+
+	writer := out.writer
+	if file != "" {
+		outputFile, err := os.Create(file)
+		if err != nil {
+			return err
+		}
+		defer outputFile.Close()
+
+		writer = outputFile
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	summary, err := bulk.NewExporter(client, opts...).Export(ctx, writer)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stderr, "exported: %d, sha256: %s\n", summary.Count, summary.Checksum)
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3"
+	"github.com/francorosatti/form3-api-client/pkg/form3/bulk"
+)
+
+const (
+	_formatCSV    = "csv"
+	_formatNDJSON = "ndjson"
+)
+
+var (
+	errImportFailures = errors.New("some accounts could not be imported")
+)
+
+func importAccounts(client form3.IClient, args []string, out output, stderr io.Writer) error {
+	var (
+		file        string
+		format      string
+		mapping     string
+		concurrency int
+		report      string
+		checkpoint  string
+	)
+
+	flags := newFlagSet("accounts import", stderr)
+	flags.StringVar(&file, "f", "", "csv or ndjson file with the accounts")
+	flags.StringVar(&format, "format", "", "input format: csv or ndjson, defaults to the file extension")
+	flags.StringVar(&mapping, "mapping", "", "csv column mapping as field=column pairs separated by commas")
+	flags.IntVar(&concurrency, "concurrency", 4, "number of accounts created at the same time")
+	flags.StringVar(&report, "report", "", "csv report file, defaults to stdout")
+	flags.StringVar(&checkpoint, "checkpoint", "", "checkpoint file used to resume an interrupted import")
+
+	if err := flags.Parse(args); err != nil || file == "" {
+		flags.Usage()
+		return errUsage
+	}
+
+	input, reader, err := openAccountFile(file, format, mapping, stderr)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	reportWriter := bulk.NewCSVReportWriter(out.writer)
+	if report != "" {
+		reportFile, err := os.OpenFile(report, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		defer reportFile.Close()
+
+		reportWriter = bulk.NewCSVReportWriter(reportFile)
+
+		// resumed imports append their results to the existing report
+		if info, err := reportFile.Stat(); err == nil && info.Size() > 0 {
+			reportWriter.SkipHeader()
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	importer := bulk.NewImporter(client, bulk.WithConcurrency(concurrency), bulk.WithCheckpoint(checkpoint))
+
+	summary, err := importer.Import(ctx, reader, reportWriter)
+
+	fmt.Fprintf(stderr, "created: %d, already exists: %d, failed: %d, skipped: %d\n",
+		summary.Created, summary.AlreadyExists, summary.Failed, summary.Skipped)
+
+	if err != nil {
+		return err
+	}
+
+	if summary.Failed > 0 {
+		return errImportFailures
+	}
+
+	return nil
+}
+
+// openAccountFile opens a csv or ndjson account file. The format defaults to
+// the file extension.
+func openAccountFile(file string, format string, mapping string, stderr io.Writer) (io.Closer, bulk.IReader, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(file)), ".")
+	}
+
+	input, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reader bulk.IReader
+	switch format {
+	case _formatCSV:
+		columnMapping, err := bulk.ParseColumnMapping(mapping)
+		if err == nil {
+			reader, err = bulk.NewCSVReader(input, columnMapping)
+		}
+		if err != nil {
+			input.Close()
+			return nil, nil, err
+		}
+	case _formatNDJSON, "jsonl":
+		reader = bulk.NewNDJSONReader(input)
+	default:
+		input.Close()
+		fmt.Fprintf(stderr, "unknown input format %q\n", format)
+		return nil, nil, errUsage
+	}
+
+	return input, reader, nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"gopkg.in/yaml.v3"
+)
+
+// readAccountFile reads an account from a json or yaml file. Yaml files use
+// the same field names as the api json.
+func readAccountFile(path string) (models.Account, error) {
+	var account models.Account
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return account, fmt.Errorf("error reading account file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		var document interface{}
+		if err = yaml.Unmarshal(content, &document); err != nil {
+			return account, fmt.Errorf("error parsing account file: %w", err)
+		}
+
+		if content, err = json.Marshal(document); err != nil {
+			return account, fmt.Errorf("error parsing account file: %w", err)
+		}
+	}
+
+	if err = json.Unmarshal(content, &account); err != nil {
+		return account, fmt.Errorf("error parsing account file: %w", err)
+	}
+
+	return account, nil
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+)
+
+const (
+	exitOK = iota
+	exitError
+	exitUsage
+	exitInvalidParameters
+	exitBadRequest
+	exitNotFound
+	exitConflict
+)
+
+const _usage = `usage: form3ctl [flags] accounts <create|fetch|delete|list|import|export|reconcile> [flags]
+
+flags:
+`
+
+type globalOptions struct {
+	environment      string
+	host             string
+	config           string
+	allowDestructive bool
+	output           string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	options := globalOptions{}
+
+	flags := flag.NewFlagSet("form3ctl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&options.environment, "env", string(form3.EnvironmentLocal), "environment: local, test or production")
+	flags.StringVar(&options.host, "base-url", "", "api host, overrides the environment host")
+	flags.StringVar(&options.config, "config", "", "yaml or json client config file, replaces -env")
+	flags.BoolVar(&options.allowDestructive, "allow-destructive", false, "allow deleting accounts in production")
+	flags.StringVar(&options.output, "o", _outputTable, "output format: table or json")
+	flags.Usage = func() {
+		fmt.Fprint(stderr, _usage)
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if flags.NArg() < 2 || flags.Arg(0) != "accounts" {
+		flags.Usage()
+		return exitUsage
+	}
+
+	if options.output != _outputTable && options.output != _outputJson {
+		fmt.Fprintf(stderr, "unknown output format %q\n", options.output)
+		return exitUsage
+	}
+
+	command, exists := _accountCommands[flags.Arg(1)]
+	if !exists {
+		flags.Usage()
+		return exitUsage
+	}
+
+	var clientOptions []form3.Option
+	if options.host != "" {
+		clientOptions = append(clientOptions, form3.WithHost(options.host))
+	}
+	if options.allowDestructive {
+		clientOptions = append(clientOptions, form3.WithDestructiveOperations())
+	}
+
+	var (
+		client form3.IClient
+		err    error
+	)
+	if options.config != "" {
+		client, err = form3.NewClientFromConfig(options.config, clientOptions...)
+	} else {
+		client, err = form3.NewClient(form3.Environment(options.environment), clientOptions...)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+
+	out := output{writer: stdout, format: options.output}
+
+	if err = command(client, flags.Args()[2:], out, stderr); err != nil {
+		if errors.Is(err, errUsage) {
+			return exitUsage
+		}
+
+		fmt.Fprintln(stderr, err)
+		return exitCode(err)
+	}
+
+	return exitOK
+}
+
+func exitCode(err error) int {
+	switch {
+	case errors.Is(err, accounts.ErrAccountInvalidParameters):
+		return exitInvalidParameters
+	case errors.Is(err, accounts.ErrAccountBadRequest):
+		return exitBadRequest
+	case errors.Is(err, accounts.ErrAccountNotFound):
+		return exitNotFound
+	case errors.Is(err, accounts.ErrAccountConflict):
+		return exitConflict
+	default:
+		return exitError
+	}
+}
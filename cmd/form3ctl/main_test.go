@@ -0,0 +1,303 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/form3test"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_run(t *testing.T) {
+	server := form3test.NewServer()
+	defer server.Close()
+
+	existingID := uuid.NewString()
+	server.AddAccount(*new(models.AccountData).
+		WithID(existingID).
+		WithOrganisationID(uuid.NewString()).
+		WithType("accounts"))
+
+	yamlFile := filepath.Join(t.TempDir(), "account.yaml")
+	require.NoError(t, os.WriteFile(yamlFile, []byte(`
+data:
+  id: `+uuid.NewString()+`
+  organisation_id: `+uuid.NewString()+`
+  attributes:
+    country: GB
+    name: [account name]
+`), 0600))
+
+	tests := []struct {
+		name         string
+		args         []string
+		expectedCode int
+	}{
+		{
+			name: "given valid account flags" +
+				"when creating account" +
+				"then exit ok",
+			args: []string{"accounts", "create", "-id", uuid.NewString(), "-organisation-id", uuid.NewString(),
+				"-country", "GB", "-name", "first", "-name", "second", "-joint-account"},
+			expectedCode: exitOK,
+		},
+		{
+			name: "given a yaml account file" +
+				"when creating account" +
+				"then exit ok",
+			args:         []string{"accounts", "create", "-f", yamlFile},
+			expectedCode: exitOK,
+		},
+		{
+			name: "given an existing account id" +
+				"when creating account" +
+				"then exit conflict",
+			args: []string{"accounts", "create", "-id", existingID, "-organisation-id", uuid.NewString(),
+				"-country", "GB", "-name", "name"},
+			expectedCode: exitConflict,
+		},
+		{
+			name: "given an invalid account" +
+				"when creating account" +
+				"then exit bad request",
+			args:         []string{"accounts", "create", "-id", "invalid"},
+			expectedCode: exitBadRequest,
+		},
+		{
+			name: "given an existing account id" +
+				"when fetching account" +
+				"then exit ok",
+			args:         []string{"accounts", "fetch", "-id", existingID},
+			expectedCode: exitOK,
+		},
+		{
+			name: "given a non existent account id" +
+				"when fetching account" +
+				"then exit not found",
+			args:         []string{"accounts", "fetch", "-id", uuid.NewString()},
+			expectedCode: exitNotFound,
+		},
+		{
+			name: "given an empty account id" +
+				"when deleting account" +
+				"then exit invalid parameters",
+			args:         []string{"accounts", "delete"},
+			expectedCode: exitInvalidParameters,
+		},
+		{
+			name: "given an unknown command" +
+				"when running" +
+				"then exit usage",
+			args:         []string{"accounts", "update"},
+			expectedCode: exitUsage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+			// Act
+			got := run(append([]string{"-base-url", server.URL}, tt.args...), stdout, stderr)
+
+			// Assert
+			assert.Equal(t, tt.expectedCode, got, stderr.String())
+		})
+	}
+}
+
+func Test_run_emptyResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{
+			name: "given an empty created response" +
+				"when creating account" +
+				"then exit error",
+			args: []string{"accounts", "create", "-id", uuid.NewString(), "-organisation-id", uuid.NewString(),
+				"-country", "GB", "-name", "name"},
+		},
+		{
+			name: "given an empty fetched response" +
+				"when fetching account" +
+				"then exit error",
+			args: []string{"accounts", "fetch", "-id", uuid.NewString()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+			// Act
+			got := run(append([]string{"-base-url", server.URL}, tt.args...), stdout, stderr)
+
+			// Assert
+			assert.Equal(t, exitError, got)
+			assert.Contains(t, stderr.String(), errEmptyResponse.Error())
+			assert.Empty(t, stdout.String())
+		})
+	}
+}
+
+func Test_run_listJson(t *testing.T) {
+	// Arrange
+	server := form3test.NewServer()
+	defer server.Close()
+
+	server.AddAccount(*new(models.AccountData).WithID(uuid.NewString()))
+	server.AddAccount(*new(models.AccountData).WithID(uuid.NewString()))
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+	// Act
+	code := run([]string{"-base-url", server.URL, "-o", "json", "accounts", "list"}, stdout, stderr)
+
+	// Assert
+	require.Equal(t, exitOK, code, stderr.String())
+
+	var got []models.AccountData
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &got))
+	assert.Equal(t, server.Accounts(), got)
+}
+
+func Test_run_import(t *testing.T) {
+	// Arrange
+	server := form3test.NewServer()
+	defer server.Close()
+
+	file := filepath.Join(t.TempDir(), "accounts.csv")
+	require.NoError(t, os.WriteFile(file, []byte("Account ID,Organisation,country,name\n"+
+		uuid.NewString()+","+uuid.NewString()+",GB,first name\n"+
+		"invalid,"+uuid.NewString()+",GB,second name\n"), 0600))
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+	// Act
+	code := run([]string{"-base-url", server.URL, "accounts", "import", "-f", file,
+		"-mapping", "id=Account ID,organisation_id=Organisation"}, stdout, stderr)
+
+	// Assert
+	assert.Equal(t, exitError, code)
+	assert.Len(t, server.Accounts(), 1)
+	assert.Contains(t, stdout.String(), ",created,")
+	assert.Contains(t, stdout.String(), "invalid,failed,")
+	assert.Contains(t, stderr.String(), "created: 1, already exists: 0, failed: 1, skipped: 0")
+}
+
+func Test_run_export(t *testing.T) {
+	// Arrange
+	server := form3test.NewServer()
+	defer server.Close()
+
+	accountID := uuid.NewString()
+	server.AddAccount(*new(models.AccountData).
+		WithID(accountID).
+		WithOrganisationID(uuid.NewString()).
+		WithType("accounts").
+		WithAttributes(*new(models.AccountAttributes).WithAccountNumber("12345678")))
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+	// Act
+	code := run([]string{"-base-url", server.URL, "accounts", "export", "-fields", "id,account_number", "-mask"},
+		stdout, stderr)
+
+	// Assert
+	assert.Equal(t, exitOK, code)
+	assert.Equal(t, "id,account_number\n"+accountID+",[REDACTED]\n", stdout.String())
+	assert.Contains(t, stderr.String(), "exported: 1, sha256: ")
+}
+
+func Test_run_reconcile(t *testing.T) {
+	// Arrange
+	server := form3test.NewServer()
+	defer server.Close()
+
+	organisationID := uuid.NewString()
+	orphanedID := uuid.NewString()
+	server.AddAccount(*new(models.AccountData).
+		WithID(orphanedID).
+		WithOrganisationID(organisationID).
+		WithType("accounts"))
+
+	missingID := uuid.NewString()
+	file := filepath.Join(t.TempDir(), "accounts.csv")
+	require.NoError(t, os.WriteFile(file, []byte("id,organisation_id,country,name\n"+
+		missingID+","+organisationID+",GB,name\n"), 0600))
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+	// Act
+	code := run([]string{"-base-url", server.URL, "accounts", "reconcile", "-f", file, "-create-missing"},
+		stdout, stderr)
+
+	// Assert
+	assert.Equal(t, exitOK, code)
+	assert.Len(t, server.Accounts(), 1)
+	assert.Contains(t, stdout.String(), "2,"+missingID+",missing_remotely,,create,false,")
+	assert.Contains(t, stdout.String(), "0,"+orphanedID+",missing_locally,,,false,")
+	assert.Contains(t, stderr.String(), "missing remotely: 1, missing locally: 1")
+}
+
+func Test_run_config(t *testing.T) {
+	// Arrange
+	server := form3test.NewServer()
+	defer server.Close()
+
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("environment: local\nbase_url: "+server.URL+"\n"), 0600))
+
+	invalidFile := filepath.Join(t.TempDir(), "invalid.yaml")
+	require.NoError(t, os.WriteFile(invalidFile, []byte("environment: unknown\n"), 0600))
+
+	// Act
+	code := run([]string{"-config", file, "accounts", "list"}, &bytes.Buffer{}, &bytes.Buffer{})
+	invalidCode := run([]string{"-config", invalidFile, "accounts", "list"}, &bytes.Buffer{}, &bytes.Buffer{})
+
+	// Assert
+	assert.Equal(t, exitOK, code)
+	assert.Equal(t, exitUsage, invalidCode)
+}
+
+func Test_run_productionDelete(t *testing.T) {
+	// Arrange
+	server := form3test.NewServer()
+	defer server.Close()
+
+	accountID := uuid.NewString()
+	server.AddAccount(*new(models.AccountData).
+		WithID(accountID).
+		WithOrganisationID(uuid.NewString()).
+		WithType("accounts"))
+
+	args := []string{"accounts", "delete", "-id", accountID, "-version", "0"}
+
+	// Act
+	blockedCode := run(append([]string{"-env", "production", "-base-url", server.URL}, args...), &bytes.Buffer{}, &bytes.Buffer{})
+	blockedAccounts := len(server.Accounts())
+	allowedCode := run(append([]string{"-env", "production", "-base-url", server.URL, "-allow-destructive"}, args...),
+		&bytes.Buffer{}, &bytes.Buffer{})
+
+	// Assert
+	assert.Equal(t, exitError, blockedCode)
+	assert.Equal(t, 1, blockedAccounts)
+	assert.Equal(t, exitOK, allowedCode)
+	assert.Empty(t, server.Accounts())
+}
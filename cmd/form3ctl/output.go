@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+)
+
+const (
+	_outputTable = "table"
+	_outputJson  = "json"
+)
+
+type output struct {
+	writer io.Writer
+	format string
+}
+
+func (o output) writeAccount(account models.AccountData) error {
+	if o.format == _outputJson {
+		return o.writeJson(account)
+	}
+
+	return o.writeTable([]models.AccountData{account})
+}
+
+func (o output) writeAccounts(accounts []models.AccountData) error {
+	if o.format == _outputJson {
+		return o.writeJson(accounts)
+	}
+
+	return o.writeTable(accounts)
+}
+
+func (o output) writeJson(value interface{}) error {
+	encoder := json.NewEncoder(o.writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(value)
+}
+
+func (o output) writeTable(accounts []models.AccountData) error {
+	writer := tabwriter.NewWriter(o.writer, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tORGANISATION ID\tVERSION\tCOUNTRY\tBANK ID\tBIC\tNAME")
+
+	for _, account := range accounts {
+		attributes := models.AccountAttributes{}
+		if account.Attributes != nil {
+			attributes = *account.Attributes
+		}
+
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			account.ID,
+			account.OrganisationID,
+			formatVersion(account.Version),
+			formatString(attributes.Country),
+			attributes.BankID,
+			attributes.Bic,
+			strings.Join(attributes.Name, " "),
+		)
+	}
+
+	return writer.Flush()
+}
+
+func formatVersion(version *int64) string {
+	if version == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", *version)
+}
+
+func formatString(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
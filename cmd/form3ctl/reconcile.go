@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3"
+	"github.com/francorosatti/form3-api-client/pkg/form3/bulk"
+)
+
+var (
+	errReconcileFailures = errors.New("some accounts could not be reconciled")
+)
+
+func reconcileAccounts(client form3.IClient, args []string, out output, stderr io.Writer) error {
+	var (
+		file           string
+		format         string
+		mapping        string
+		organisationID string
+		createMissing  bool
+		deleteOrphaned bool
+		apply          bool
+	)
+
+	flags := newFlagSet("accounts reconcile", stderr)
+	flags.StringVar(&file, "f", "", "csv or ndjson file with the expected accounts")
+	flags.StringVar(&format, "format", "", "input format: csv or ndjson, defaults to the file extension")
+	flags.StringVar(&mapping, "mapping", "", "csv column mapping as field=column pairs separated by commas")
+	flags.StringVar(&organisationID, "organisation-id", "", "reconcile only the accounts of this organisation")
+	flags.BoolVar(&createMissing, "create-missing", false, "create accounts missing remotely")
+	flags.BoolVar(&deleteOrphaned, "delete-orphaned", false, "delete accounts missing locally")
+	flags.BoolVar(&apply, "apply", false, "apply the fixes, by default they are only reported")
+
+	if err := flags.Parse(args); err != nil || file == "" {
+		flags.Usage()
+		return errUsage
+	}
+
+	input, reader, err := openAccountFile(file, format, mapping, stderr)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	opts := []bulk.ReconcilerOption{bulk.WithReconcileOrganisationID(organisationID)}
+	if createMissing {
+		opts = append(opts, bulk.WithCreateMissing())
+	}
+	if deleteOrphaned {
+		opts = append(opts, bulk.WithDeleteOrphaned())
+	}
+	if apply {
+		opts = append(opts, bulk.WithApply())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	report, err := bulk.NewReconciler(client, opts...).Reconcile(ctx, reader)
+	if writeErr := writeReconcileReport(out.writer, report); writeErr != nil && err == nil {
+		err = writeErr
+	}
+
+	summary := report.Summary
+	fmt.Fprintf(stderr, "matching: %d, divergent: %d, missing remotely: %d, missing locally: %d, invalid: %d, "+
+		"created: %d, deleted: %d, failed: %d\n", summary.Matching, summary.Divergent, summary.MissingRemotely,
+		summary.MissingLocally, summary.Invalid, summary.Created, summary.Deleted, summary.Failed)
+
+	if err != nil {
+		return err
+	}
+
+	if summary.Failed > 0 {
+		return errReconcileFailures
+	}
+
+	return nil
+}
+
+// writeReconcileReport writes one csv line per account that is not matching.
+func writeReconcileReport(w io.Writer, report bulk.ReconcileReport) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"row", "account_id", "classification", "diff", "action", "applied", "error"}); err != nil {
+		return err
+	}
+
+	for _, discrepancy := range report.Discrepancies {
+		if discrepancy.Classification == bulk.ClassificationMatching {
+			continue
+		}
+
+		diffs := make([]string, 0, len(discrepancy.Diffs))
+		for _, diff := range discrepancy.Diffs {
+			diffs = append(diffs, fmt.Sprintf("%s: %q != %q", diff.Field, diff.Local, diff.Remote))
+		}
+
+		var reason string
+		if discrepancy.Err != nil {
+			reason = discrepancy.Err.Error()
+		}
+
+		err := writer.Write([]string{
+			strconv.Itoa(discrepancy.Row),
+			discrepancy.AccountID,
+			string(discrepancy.Classification),
+			strings.Join(diffs, "; "),
+			string(discrepancy.Action),
+			strconv.FormatBool(discrepancy.Applied),
+			reason,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,18 @@
+package form3
+
+import (
+	"net/http"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+)
+
+type bearerTokenHttpClient struct {
+	next  endpoints.IHttpClient
+	token string
+}
+
+func (c bearerTokenHttpClient) Do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	return c.next.Do(req)
+}
@@ -0,0 +1,113 @@
+package bulk
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+)
+
+// checkpoint tracks the last row up to which every row has been processed.
+// Rows finish out of order when imported concurrently, so finished rows are
+// only committed once every row read before them has finished too.
+type checkpoint struct {
+	path      string
+	resumeRow int
+
+	mu       sync.Mutex
+	row      int
+	queue    []int
+	finished map[int]struct{}
+}
+
+type checkpointFile struct {
+	Row int `json:"row"`
+}
+
+func newCheckpoint(path string) (*checkpoint, error) {
+	c := &checkpoint{
+		path:     path,
+		finished: make(map[int]struct{}),
+	}
+
+	if path == "" {
+		return c, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, endpoints.WrapError(ErrCheckpoint, err)
+	}
+
+	var file checkpointFile
+	if err = json.Unmarshal(content, &file); err != nil {
+		return nil, endpoints.WrapError(ErrCheckpoint, err)
+	}
+
+	c.resumeRow = file.Row
+	c.row = file.Row
+
+	return c, nil
+}
+
+// skip reports whether a row was processed by a previous import.
+func (c *checkpoint) skip(row int) bool {
+	return row <= c.resumeRow
+}
+
+// start registers a row, in reading order, before it is processed.
+func (c *checkpoint) start(row int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.queue = append(c.queue, row)
+}
+
+// done marks a row as processed and saves the checkpoint when it advances.
+func (c *checkpoint) done(row int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.finished[row] = struct{}{}
+
+	advanced := false
+	for len(c.queue) > 0 {
+		if _, exists := c.finished[c.queue[0]]; !exists {
+			break
+		}
+
+		delete(c.finished, c.queue[0])
+		c.row = c.queue[0]
+		c.queue = c.queue[1:]
+		advanced = true
+	}
+
+	if !advanced || c.path == "" {
+		return nil
+	}
+
+	return c.save()
+}
+
+func (c *checkpoint) save() error {
+	content, err := json.Marshal(checkpointFile{Row: c.row})
+	if err != nil {
+		return endpoints.WrapError(ErrCheckpoint, err)
+	}
+
+	tmp := c.path + ".tmp"
+	if err = os.WriteFile(tmp, content, 0644); err != nil {
+		return endpoints.WrapError(ErrCheckpoint, err)
+	}
+
+	if err = os.Rename(tmp, c.path); err != nil {
+		return endpoints.WrapError(ErrCheckpoint, err)
+	}
+
+	return nil
+}
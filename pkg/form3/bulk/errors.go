@@ -0,0 +1,16 @@
+package bulk
+
+import "errors"
+
+var (
+	ErrInvalidColumnMapping = errors.New("invalid column mapping")
+	ErrReadHeader           = errors.New("error reading csv header")
+	ErrParseRow             = errors.New("error parsing row")
+	ErrInvalidAccount       = errors.New("invalid account")
+	ErrUnidentifiedRow      = errors.New("ledger row without a valid id")
+	ErrCheckpoint           = errors.New("error reading or writing checkpoint")
+	ErrUnknownField         = errors.New("unknown field")
+	ErrUnknownFormat        = errors.New("unknown export format")
+	ErrListAccounts         = errors.New("error listing accounts")
+	ErrWriteAccount         = errors.New("error writing account")
+)
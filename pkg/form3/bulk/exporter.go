@@ -0,0 +1,190 @@
+package bulk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+)
+
+const (
+	FormatCSV      Format = "csv"
+	FormatNDJSON   Format = "ndjson"
+	FormatColumnar Format = "columnar"
+
+	_defaultPageSize = 100
+)
+
+type (
+	Format string
+
+	ExporterOption func(*Exporter)
+
+	// Exporter writes every account returned by the list endpoint.
+	Exporter struct {
+		client         accounts.IAccountClient
+		format         Format
+		fields         []string
+		pageSize       int
+		organisationID string
+		mask           bool
+	}
+
+	// ExportSummary holds the number of exported accounts and the hex
+	// encoded sha256 of the written output.
+	ExportSummary struct {
+		Count    int
+		Checksum string
+	}
+)
+
+func NewExporter(client accounts.IAccountClient, opts ...ExporterOption) *Exporter {
+	exporter := &Exporter{
+		client:   client,
+		format:   FormatCSV,
+		fields:   Fields,
+		pageSize: _defaultPageSize,
+	}
+
+	for _, opt := range opts {
+		opt(exporter)
+	}
+
+	return exporter
+}
+
+func WithFormat(format Format) ExporterOption {
+	return func(exporter *Exporter) {
+		exporter.format = format
+	}
+}
+
+// WithFields selects the exported fields and their order.
+func WithFields(fields []string) ExporterOption {
+	return func(exporter *Exporter) {
+		if len(fields) > 0 {
+			exporter.fields = fields
+		}
+	}
+}
+
+func WithPageSize(pageSize int) ExporterOption {
+	return func(exporter *Exporter) {
+		if pageSize > 0 {
+			exporter.pageSize = pageSize
+		}
+	}
+}
+
+// WithOrganisationID exports only the accounts of the given organisation.
+func WithOrganisationID(organisationID string) ExporterOption {
+	return func(exporter *Exporter) {
+		exporter.organisationID = organisationID
+	}
+}
+
+// WithMasking replaces the values of sensitive attributes, like account
+// numbers and names, with logging.Redacted.
+func WithMasking() ExporterOption {
+	return func(exporter *Exporter) {
+		exporter.mask = true
+	}
+}
+
+// ParseFields parses a comma separated list of field names.
+func ParseFields(value string) ([]string, error) {
+	if value == "" {
+		return Fields, nil
+	}
+
+	fields := strings.Split(value, ",")
+	for i, field := range fields {
+		fields[i] = strings.TrimSpace(field)
+	}
+
+	if err := validateFields(fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// Export walks every page of the list endpoint and writes the accounts to w.
+// Csv and ndjson are streamed, one account at a time, while the columnar
+// format is kept in memory until the last page has been read.
+func (e *Exporter) Export(ctx context.Context, w io.Writer) (ExportSummary, error) {
+	summary := ExportSummary{}
+
+	if err := validateFields(e.fields); err != nil {
+		return summary, err
+	}
+
+	hash := sha256.New()
+
+	writer, err := newAccountWriter(io.MultiWriter(w, hash), e.format, e.fields, e.mask)
+	if err != nil {
+		return summary, err
+	}
+
+	err = walkAccounts(ctx, e.client, e.pageSize, func(data models.AccountData) error {
+		if e.organisationID != "" && data.OrganisationID != e.organisationID {
+			return nil
+		}
+
+		if err := writer.write(data); err != nil {
+			return endpoints.WrapError(ErrWriteAccount, err)
+		}
+
+		summary.Count++
+
+		return nil
+	})
+	if err != nil {
+		return summary, err
+	}
+
+	if err := writer.flush(); err != nil {
+		return summary, endpoints.WrapError(ErrWriteAccount, err)
+	}
+
+	summary.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	return summary, nil
+}
+
+// walkAccounts calls fn with every account of every page of the list
+// endpoint, stopping at the first error.
+func walkAccounts(ctx context.Context, client accounts.IAccountClient, pageSize int, fn func(models.AccountData) error) error {
+	for pageNumber := 0; ; pageNumber++ {
+		list, err := client.ListAccounts(pageNumber, pageSize, accounts.WithContext(ctx))
+		if err != nil {
+			return endpoints.WrapError(ErrListAccounts, fmt.Errorf("page %d: %w", pageNumber, err))
+		}
+
+		for _, data := range list.Data {
+			if err := fn(data); err != nil {
+				return err
+			}
+		}
+
+		if !list.HasNext() || len(list.Data) == 0 {
+			return nil
+		}
+	}
+}
+
+func validateFields(fields []string) error {
+	for _, field := range fields {
+		if _, exists := _fieldValues[field]; !exists {
+			return fmt.Errorf("%w: %q", ErrUnknownField, field)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package bulk
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+	"github.com/francorosatti/form3-api-client/pkg/form3/form3mock"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExporter_Export(t *testing.T) {
+	organisationID := uuid.NewString()
+	firstID := uuid.NewString()
+	secondID := uuid.NewString()
+
+	tests := []struct {
+		name        string
+		opts        []ExporterOption
+		expectedOut string
+	}{
+		{
+			name: "given csv format and selected fields" +
+				"when exporting accounts" +
+				"then write one row per account with joined names",
+			opts: []ExporterOption{WithFields([]string{FieldID, FieldName, FieldJointAccount})},
+			expectedOut: "id,name,joint_account\n" +
+				firstID + ",first;second,true\n" +
+				secondID + ",other,\n",
+		},
+		{
+			name: "given masking" +
+				"when exporting accounts as csv" +
+				"then replace sensitive values",
+			opts: []ExporterOption{WithFields([]string{FieldID, FieldAccountNumber, FieldName}), WithMasking()},
+			expectedOut: "id,account_number,name\n" +
+				firstID + ",[REDACTED],[REDACTED]\n" +
+				secondID + ",,[REDACTED]\n",
+		},
+		{
+			name: "given ndjson format and selected fields" +
+				"when exporting accounts" +
+				"then write one json line per account with the selected fields",
+			opts: []ExporterOption{WithFormat(FormatNDJSON), WithFields([]string{FieldID, FieldVersion, FieldCountry})},
+			expectedOut: `{"attributes":{"country":"GB"},"id":"` + firstID + `","version":0}` + "\n" +
+				`{"attributes":{"country":"GB"},"id":"` + secondID + `","version":0}` + "\n",
+		},
+		{
+			name: "given columnar format" +
+				"when exporting accounts" +
+				"then write one array per field",
+			opts: []ExporterOption{WithFormat(FormatColumnar), WithFields([]string{FieldID, FieldName})},
+			expectedOut: `{"fields":["id","name"],"count":2,"columns":{"id":["` + firstID + `","` + secondID + `"],` +
+				`"name":["first;second","other"]}}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			client := form3mock.NewStatefulClient()
+			first := newExportAccount(firstID, organisationID, "first", "second")
+			first.Attributes.WithAccountNumber("12345678").WithJointAccount(true)
+
+			createAccount(t, client, *first)
+			createAccount(t, client, *newExportAccount(secondID, organisationID, "other"))
+
+			out := &bytes.Buffer{}
+			exporter := NewExporter(client, append(tt.opts, WithPageSize(1))...)
+
+			// Act
+			summary, err := exporter.Export(context.Background(), out)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOut, out.String())
+			assert.Equal(t, 2, summary.Count)
+
+			checksum := sha256.Sum256(out.Bytes())
+			assert.Equal(t, hex.EncodeToString(checksum[:]), summary.Checksum)
+		})
+	}
+}
+
+func TestExporter_Export_organisation(t *testing.T) {
+	// Arrange
+	client := form3mock.NewStatefulClient()
+	organisationID := uuid.NewString()
+	accountID := uuid.NewString()
+
+	createAccount(t, client, *newExportAccount(accountID, organisationID, "name"))
+	createAccount(t, client, *newExportAccount(uuid.NewString(), uuid.NewString(), "name"))
+
+	out := &bytes.Buffer{}
+	exporter := NewExporter(client, WithOrganisationID(organisationID), WithFields([]string{FieldID}))
+
+	// Act
+	summary, err := exporter.Export(context.Background(), out)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, summary.Count)
+	assert.Equal(t, "id\n"+accountID+"\n", out.String())
+}
+
+func TestExporter_Export_errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		client        accounts.IAccountClient
+		opts          []ExporterOption
+		expectedError error
+	}{
+		{
+			name: "given an unknown field" +
+				"when exporting accounts" +
+				"then return unknown field error",
+			client:        form3mock.NewStatefulClient(),
+			opts:          []ExporterOption{WithFields([]string{"unknown"})},
+			expectedError: ErrUnknownField,
+		},
+		{
+			name: "given an unknown format" +
+				"when exporting accounts" +
+				"then return unknown format error",
+			client:        form3mock.NewStatefulClient(),
+			opts:          []ExporterOption{WithFormat("xml")},
+			expectedError: ErrUnknownFormat,
+		},
+		{
+			name: "given list accounts fails" +
+				"when exporting accounts" +
+				"then return list accounts error",
+			client:        new(form3mock.Client).ReturnListAccounts(models.AccountList{}, errors.New("unexpected")),
+			expectedError: ErrListAccounts,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			exporter := NewExporter(tt.client, tt.opts...)
+
+			// Act
+			_, err := exporter.Export(context.Background(), &bytes.Buffer{})
+
+			// Assert
+			assert.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	// Act
+	fields, err := ParseFields("id, name")
+	_, unknownErr := ParseFields("id,unknown")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{FieldID, FieldName}, fields)
+	assert.ErrorIs(t, unknownErr, ErrUnknownField)
+}
+
+func newExportAccount(id string, organisationID string, names ...string) *models.AccountData {
+	attributes := models.AccountAttributes{}
+	attributes.WithCountry("GB").WithName(names)
+
+	return new(models.AccountData).
+		WithID(id).
+		WithOrganisationID(organisationID).
+		WithType("accounts").
+		WithAttributes(attributes)
+}
+
+func createAccount(t *testing.T, client accounts.IAccountClient, data models.AccountData) {
+	t.Helper()
+
+	_, err := client.CreateAccount(*new(models.Account).WithData(data))
+	require.NoError(t, err)
+}
@@ -0,0 +1,239 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/google/uuid"
+)
+
+const (
+	StatusCreated       Status = "created"
+	StatusAlreadyExists Status = "already_exists"
+	StatusFailed        Status = "failed"
+
+	_defaultConcurrency = 4
+)
+
+type (
+	Status string
+
+	ImporterOption func(*Importer)
+
+	// Importer creates accounts read from a file with bounded concurrency.
+	Importer struct {
+		client      accounts.IAccountClient
+		concurrency int
+		checkpoint  string
+		validate    func(models.AccountData) error
+	}
+
+	Result struct {
+		Row       int
+		AccountID string
+		Status    Status
+		Reason    string
+	}
+
+	ImportSummary struct {
+		Created       int
+		AlreadyExists int
+		Failed        int
+		Skipped       int
+	}
+)
+
+func NewImporter(client accounts.IAccountClient, opts ...ImporterOption) *Importer {
+	importer := &Importer{
+		client:      client,
+		concurrency: _defaultConcurrency,
+		validate:    ValidateAccount,
+	}
+
+	for _, opt := range opts {
+		opt(importer)
+	}
+
+	return importer
+}
+
+func WithConcurrency(concurrency int) ImporterOption {
+	return func(importer *Importer) {
+		if concurrency > 0 {
+			importer.concurrency = concurrency
+		}
+	}
+}
+
+// WithCheckpoint keeps track of the last row below which every row has been
+// processed, so that an interrupted import can be resumed from it. Rows
+// processed past the checkpoint are reported as already existing on resume.
+func WithCheckpoint(path string) ImporterOption {
+	return func(importer *Importer) {
+		importer.checkpoint = path
+	}
+}
+
+func WithValidation(validate func(models.AccountData) error) ImporterOption {
+	return func(importer *Importer) {
+		importer.validate = validate
+	}
+}
+
+// Import creates every account read from reader and writes one result per
+// row to report. It stops reading rows when ctx is cancelled, waiting for
+// in-flight rows to finish.
+func (i *Importer) Import(ctx context.Context, reader IReader, report IReportWriter) (ImportSummary, error) {
+	summary := ImportSummary{}
+
+	checkpoint, err := newCheckpoint(i.checkpoint)
+	if err != nil {
+		return summary, err
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		rows     = make(chan Row)
+		writeErr error
+	)
+
+	for w := 0; w < i.concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for row := range rows {
+				result := i.importRow(ctx, row)
+
+				// rows interrupted by cancellation are neither reported nor
+				// checkpointed, so that they are imported again on resume
+				if result.Status == StatusFailed && ctx.Err() != nil {
+					continue
+				}
+
+				mu.Lock()
+				summary.add(result.Status)
+				if err := report.Write(result); err != nil && writeErr == nil {
+					writeErr = err
+				}
+				if err := checkpoint.done(row.Number); err != nil && writeErr == nil {
+					writeErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	skipped, readErr := i.readRows(ctx, reader, checkpoint, rows)
+	close(rows)
+	wg.Wait()
+
+	summary.Skipped = skipped
+
+	if readErr != nil {
+		return summary, readErr
+	}
+
+	if writeErr != nil {
+		return summary, writeErr
+	}
+
+	return summary, ctx.Err()
+}
+
+func (i *Importer) readRows(ctx context.Context, reader IReader, checkpoint *checkpoint, rows chan<- Row) (int, error) {
+	skipped := 0
+
+	for {
+		row, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return skipped, nil
+		}
+		if err != nil {
+			return skipped, err
+		}
+
+		if checkpoint.skip(row.Number) {
+			skipped++
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return skipped, nil
+		}
+
+		checkpoint.start(row.Number)
+
+		select {
+		case rows <- row:
+		case <-ctx.Done():
+			return skipped, nil
+		}
+	}
+}
+
+func (i *Importer) importRow(ctx context.Context, row Row) Result {
+	result := Result{
+		Row:       row.Number,
+		AccountID: row.Data.ID,
+	}
+
+	err := row.Err
+	if err == nil {
+		err = i.validate(row.Data)
+	}
+	if err == nil {
+		_, err = i.client.CreateAccount(*new(models.Account).WithData(row.Data), accounts.WithContext(ctx))
+	}
+
+	switch {
+	case err == nil:
+		result.Status = StatusCreated
+	case errors.Is(err, accounts.ErrAccountConflict):
+		result.Status = StatusAlreadyExists
+	default:
+		result.Status = StatusFailed
+		result.Reason = err.Error()
+	}
+
+	return result
+}
+
+func (s *ImportSummary) add(status Status) {
+	switch status {
+	case StatusCreated:
+		s.Created++
+	case StatusAlreadyExists:
+		s.AlreadyExists++
+	default:
+		s.Failed++
+	}
+}
+
+// ValidateAccount checks the fields required to create an account before
+// calling the api.
+func ValidateAccount(data models.AccountData) error {
+	if _, err := uuid.Parse(data.ID); err != nil {
+		return fmt.Errorf("%w: %s must be a uuid", ErrInvalidAccount, FieldID)
+	}
+
+	if _, err := uuid.Parse(data.OrganisationID); err != nil {
+		return fmt.Errorf("%w: %s must be a uuid", ErrInvalidAccount, FieldOrganisationID)
+	}
+
+	if data.Attributes == nil || data.Attributes.Country == nil || *data.Attributes.Country == "" {
+		return fmt.Errorf("%w: %s is required", ErrInvalidAccount, FieldCountry)
+	}
+
+	if len(data.Attributes.Name) == 0 {
+		return fmt.Errorf("%w: %s is required", ErrInvalidAccount, FieldName)
+	}
+
+	return nil
+}
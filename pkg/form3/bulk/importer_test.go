@@ -0,0 +1,107 @@
+package bulk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/form3mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImporter_Import(t *testing.T) {
+	// Arrange
+	client := form3mock.NewStatefulClient()
+	organisationID := uuid.NewString()
+	existingID := uuid.NewString()
+	newID := uuid.NewString()
+
+	lines := []string{
+		accountLine(existingID, organisationID),
+		accountLine(existingID, organisationID),
+		accountLine("invalid_id", organisationID),
+		accountLine(newID, organisationID),
+	}
+
+	report := &bytes.Buffer{}
+	importer := NewImporter(client, WithConcurrency(1))
+
+	// Act
+	summary, err := importer.Import(context.Background(), NewNDJSONReader(strings.NewReader(strings.Join(lines, "\n"))), NewCSVReportWriter(report))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, ImportSummary{Created: 2, AlreadyExists: 1, Failed: 1}, summary)
+	assert.Equal(t, "row,account_id,status,reason\n"+
+		"1,"+existingID+",created,\n"+
+		"2,"+existingID+",already_exists,\n"+
+		"3,invalid_id,failed,invalid account: id must be a uuid\n"+
+		"4,"+newID+",created,\n", report.String())
+}
+
+func TestImporter_Import_checkpoint(t *testing.T) {
+	// Arrange
+	checkpointFile := filepath.Join(t.TempDir(), "checkpoint.json")
+	require.NoError(t, os.WriteFile(checkpointFile, []byte(`{"row":2}`), 0644))
+
+	organisationID := uuid.NewString()
+	var lines []string
+	for i := 0; i < 10; i++ {
+		lines = append(lines, accountLine(uuid.NewString(), organisationID))
+	}
+
+	client := form3mock.NewStatefulClient()
+	importer := NewImporter(client, WithConcurrency(3), WithCheckpoint(checkpointFile))
+
+	// Act
+	summary, err := importer.Import(context.Background(), NewNDJSONReader(strings.NewReader(strings.Join(lines, "\n"))), NewCSVReportWriter(&bytes.Buffer{}))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, ImportSummary{Created: 8, Skipped: 2}, summary)
+
+	content, err := os.ReadFile(checkpointFile)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"row":10}`, string(content))
+}
+
+func Test_checkpoint_done(t *testing.T) {
+	// Arrange
+	c, err := newCheckpoint("")
+	require.NoError(t, err)
+
+	for _, row := range []int{2, 3, 5} {
+		c.start(row)
+	}
+
+	// Act & Assert
+	require.NoError(t, c.done(3))
+	assert.Equal(t, 0, c.row)
+
+	require.NoError(t, c.done(2))
+	assert.Equal(t, 3, c.row)
+
+	require.NoError(t, c.done(5))
+	assert.Equal(t, 5, c.row)
+}
+
+func accountLine(id string, organisationID string) string {
+	line, _ := json.Marshal(map[string]interface{}{
+		"id":              id,
+		"organisation_id": organisationID,
+		"type":            "accounts",
+		"attributes": map[string]interface{}{
+			"country": "GB",
+			"name":    []string{fmt.Sprintf("name %s", id)},
+		},
+	})
+
+	return string(line)
+}
@@ -0,0 +1,285 @@
+package bulk
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+)
+
+const (
+	FieldID                      = "id"
+	FieldOrganisationID          = "organisation_id"
+	FieldType                    = "type"
+	FieldVersion                 = "version"
+	FieldAccountClassification   = "account_classification"
+	FieldAccountMatchingOptOut   = "account_matching_opt_out"
+	FieldAccountNumber           = "account_number"
+	FieldAlternativeNames        = "alternative_names"
+	FieldBankID                  = "bank_id"
+	FieldBankIDCode              = "bank_id_code"
+	FieldBaseCurrency            = "base_currency"
+	FieldBic                     = "bic"
+	FieldCountry                 = "country"
+	FieldIban                    = "iban"
+	FieldJointAccount            = "joint_account"
+	FieldName                    = "name"
+	FieldSecondaryIdentification = "secondary_identification"
+	FieldStatus                  = "status"
+	FieldSwitched                = "switched"
+
+	// ListSeparator separates the values of list fields, like name, in a
+	// single csv column.
+	ListSeparator = ";"
+
+	_accountType = "accounts"
+)
+
+var (
+	Fields = []string{
+		FieldID,
+		FieldOrganisationID,
+		FieldType,
+		FieldVersion,
+		FieldAccountClassification,
+		FieldAccountMatchingOptOut,
+		FieldAccountNumber,
+		FieldAlternativeNames,
+		FieldBankID,
+		FieldBankIDCode,
+		FieldBaseCurrency,
+		FieldBic,
+		FieldCountry,
+		FieldIban,
+		FieldJointAccount,
+		FieldName,
+		FieldSecondaryIdentification,
+		FieldStatus,
+		FieldSwitched,
+	}
+)
+
+type (
+	// IReader reads accounts one row at a time and returns io.EOF when there
+	// are no more rows. Rows that cannot be parsed carry their error.
+	IReader interface {
+		Next() (Row, error)
+	}
+
+	Row struct {
+		Number int
+		Data   models.AccountData
+		Err    error
+	}
+
+	// ColumnMapping maps account fields to csv column headers.
+	ColumnMapping map[string]string
+
+	CSVReader struct {
+		reader  *csv.Reader
+		columns map[string]int
+		number  int
+	}
+
+	NDJSONReader struct {
+		scanner *bufio.Scanner
+		number  int
+	}
+)
+
+// DefaultColumnMapping maps every field to a column with the same name.
+func DefaultColumnMapping() ColumnMapping {
+	mapping := make(ColumnMapping, len(Fields))
+	for _, field := range Fields {
+		mapping[field] = field
+	}
+
+	return mapping
+}
+
+// ParseColumnMapping parses a comma separated list of field=column pairs.
+// Fields that are not listed keep their default column.
+func ParseColumnMapping(value string) (ColumnMapping, error) {
+	mapping := DefaultColumnMapping()
+	if value == "" {
+		return mapping, nil
+	}
+
+	for _, pair := range strings.Split(value, ",") {
+		field, column, found := strings.Cut(pair, "=")
+		if !found || column == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidColumnMapping, pair)
+		}
+
+		if _, exists := mapping[field]; !exists {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidColumnMapping, field)
+		}
+
+		mapping[field] = column
+	}
+
+	return mapping, nil
+}
+
+// NewCSVReader reads accounts from csv with a header row. Columns that are
+// not mapped are ignored.
+func NewCSVReader(r io.Reader, mapping ColumnMapping) (*CSVReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, endpoints.WrapError(ErrReadHeader, err)
+	}
+
+	indexByColumn := make(map[string]int, len(header))
+	for i, column := range header {
+		indexByColumn[strings.TrimSpace(column)] = i
+	}
+
+	columns := make(map[string]int)
+	for field, column := range mapping {
+		if index, exists := indexByColumn[column]; exists {
+			columns[field] = index
+		}
+	}
+
+	return &CSVReader{
+		reader:  reader,
+		columns: columns,
+		number:  1,
+	}, nil
+}
+
+func (r *CSVReader) Next() (Row, error) {
+	record, err := r.reader.Read()
+	if err == io.EOF {
+		return Row{}, io.EOF
+	}
+
+	r.number++
+	row := Row{Number: r.number}
+
+	if err != nil {
+		row.Err = endpoints.WrapError(ErrParseRow, err)
+		return row, nil
+	}
+
+	values := make(map[string]string, len(r.columns))
+	for field, index := range r.columns {
+		if index < len(record) {
+			values[field] = strings.TrimSpace(record[index])
+		}
+	}
+
+	row.Data, row.Err = accountDataFromValues(values)
+
+	return row, nil
+}
+
+func NewNDJSONReader(r io.Reader) *NDJSONReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	return &NDJSONReader{scanner: scanner}
+}
+
+func (r *NDJSONReader) Next() (Row, error) {
+	for r.scanner.Scan() {
+		r.number++
+
+		line := strings.TrimSpace(r.scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		row := Row{Number: r.number}
+		if err := json.Unmarshal([]byte(line), &row.Data); err != nil {
+			row.Err = endpoints.WrapError(ErrParseRow, err)
+		}
+
+		return row, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return Row{}, endpoints.WrapError(ErrParseRow, err)
+	}
+
+	return Row{}, io.EOF
+}
+
+func accountDataFromValues(values map[string]string) (models.AccountData, error) {
+	data := models.AccountData{}
+	attributes := models.AccountAttributes{}
+
+	data.WithID(values[FieldID]).
+		WithOrganisationID(values[FieldOrganisationID]).
+		WithType(valueOrDefault(values[FieldType], _accountType))
+
+	attributes.WithAccountNumber(values[FieldAccountNumber]).
+		WithBankID(values[FieldBankID]).
+		WithBankIDCode(values[FieldBankIDCode]).
+		WithBaseCurrency(values[FieldBaseCurrency]).
+		WithBic(values[FieldBic]).
+		WithIban(values[FieldIban]).
+		WithSecondaryIdentification(values[FieldSecondaryIdentification])
+
+	if value := values[FieldVersion]; value != "" {
+		version, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return data, fmt.Errorf("%w: invalid %s %q", ErrParseRow, FieldVersion, value)
+		}
+		data.WithVersion(version)
+	}
+
+	if value := values[FieldAccountClassification]; value != "" {
+		attributes.WithAccountClassification(value)
+	}
+	if value := values[FieldCountry]; value != "" {
+		attributes.WithCountry(value)
+	}
+	if value := values[FieldStatus]; value != "" {
+		attributes.WithStatus(value)
+	}
+	if value := values[FieldName]; value != "" {
+		attributes.WithName(strings.Split(value, ListSeparator))
+	}
+	if value := values[FieldAlternativeNames]; value != "" {
+		attributes.WithAlternativeNames(strings.Split(value, ListSeparator))
+	}
+
+	for field, with := range map[string]func(bool) *models.AccountAttributes{
+		FieldAccountMatchingOptOut: attributes.WithAccountMatchingOptOut,
+		FieldJointAccount:          attributes.WithJointAccount,
+		FieldSwitched:              attributes.WithSwitched,
+	} {
+		value := values[field]
+		if value == "" {
+			continue
+		}
+
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return data, fmt.Errorf("%w: invalid %s %q", ErrParseRow, field, value)
+		}
+		with(parsed)
+	}
+
+	data.WithAttributes(attributes)
+
+	return data, nil
+}
+
+func valueOrDefault(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
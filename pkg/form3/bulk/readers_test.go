@@ -0,0 +1,105 @@
+package bulk
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVReader(t *testing.T) {
+	// Arrange
+	mapping, err := ParseColumnMapping("id=Account ID,country=Country Code,name=Holder")
+	require.NoError(t, err)
+
+	csv := "Account ID,Country Code,Holder,joint_account,ignored\n" +
+		"id1,GB,first;second,true,x\n" +
+		"id2,GB,name,invalid,x\n"
+
+	reader, err := NewCSVReader(strings.NewReader(csv), mapping)
+	require.NoError(t, err)
+
+	// Act
+	first, firstErr := reader.Next()
+	second, secondErr := reader.Next()
+	_, eofErr := reader.Next()
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, first.Err)
+	assert.Equal(t, 2, first.Number)
+	assert.Equal(t, *new(models.AccountData).
+		WithID("id1").
+		WithType("accounts").
+		WithAttributes(*new(models.AccountAttributes).
+			WithCountry("GB").
+			WithName([]string{"first", "second"}).
+			WithJointAccount(true)), first.Data)
+
+	require.NoError(t, secondErr)
+	assert.Equal(t, 3, second.Number)
+	assert.True(t, errors.Is(second.Err, ErrParseRow))
+
+	assert.Equal(t, io.EOF, eofErr)
+}
+
+func TestParseColumnMapping(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expectedErr error
+	}{
+		{
+			name: "given an empty mapping" +
+				"when parsing column mapping" +
+				"then return default mapping",
+			value:       "",
+			expectedErr: nil,
+		},
+		{
+			name: "given an unknown field" +
+				"when parsing column mapping" +
+				"then return error",
+			value:       "unknown=column",
+			expectedErr: ErrInvalidColumnMapping,
+		},
+		{
+			name: "given a pair without column" +
+				"when parsing column mapping" +
+				"then return error",
+			value:       "id",
+			expectedErr: ErrInvalidColumnMapping,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := ParseColumnMapping(tt.value)
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func TestNDJSONReader(t *testing.T) {
+	// Arrange
+	reader := NewNDJSONReader(strings.NewReader("{\"id\":\"id1\"}\n\n{invalid\n"))
+
+	// Act
+	first, firstErr := reader.Next()
+	second, secondErr := reader.Next()
+	_, eofErr := reader.Next()
+
+	// Assert
+	require.NoError(t, firstErr)
+	assert.Equal(t, models.AccountData{ID: "id1"}, first.Data)
+	require.NoError(t, secondErr)
+	assert.Equal(t, 3, second.Number)
+	assert.True(t, errors.Is(second.Err, ErrParseRow))
+	assert.Equal(t, io.EOF, eofErr)
+}
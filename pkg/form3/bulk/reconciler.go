@@ -0,0 +1,324 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/google/uuid"
+)
+
+const (
+	ClassificationMatching        Classification = "matching"
+	ClassificationDivergent       Classification = "divergent"
+	ClassificationMissingRemotely Classification = "missing_remotely"
+	ClassificationMissingLocally  Classification = "missing_locally"
+	ClassificationInvalid         Classification = "invalid"
+
+	ActionNone   Action = ""
+	ActionCreate Action = "create"
+	ActionDelete Action = "delete"
+)
+
+type (
+	Classification string
+
+	Action string
+
+	ReconcilerOption func(*Reconciler)
+
+	// Reconciler compares the accounts of a local ledger with the accounts
+	// held by the api and, optionally, fixes the differences.
+	Reconciler struct {
+		client         accounts.IAccountClient
+		pageSize       int
+		organisationID string
+		createMissing  bool
+		deleteOrphaned bool
+		apply          bool
+		validate       func(models.AccountData) error
+	}
+
+	FieldDiff struct {
+		Field  string
+		Local  string
+		Remote string
+	}
+
+	// Discrepancy is the result of reconciling a single account. Action is
+	// the planned fix, which is only executed when Applied is true.
+	Discrepancy struct {
+		Row            int
+		AccountID      string
+		Classification Classification
+		Diffs          []FieldDiff
+		Action         Action
+		Applied        bool
+		Err            error
+	}
+
+	ReconcileSummary struct {
+		Matching        int
+		Divergent       int
+		MissingRemotely int
+		MissingLocally  int
+		Invalid         int
+		Created         int
+		Deleted         int
+		Failed          int
+	}
+
+	ReconcileReport struct {
+		Discrepancies []Discrepancy
+		Summary       ReconcileSummary
+	}
+)
+
+func NewReconciler(client accounts.IAccountClient, opts ...ReconcilerOption) *Reconciler {
+	reconciler := &Reconciler{
+		client:   client,
+		pageSize: _defaultPageSize,
+		validate: ValidateAccount,
+	}
+
+	for _, opt := range opts {
+		opt(reconciler)
+	}
+
+	return reconciler
+}
+
+// WithCreateMissing plans the creation of accounts missing remotely.
+func WithCreateMissing() ReconcilerOption {
+	return func(reconciler *Reconciler) {
+		reconciler.createMissing = true
+	}
+}
+
+// WithDeleteOrphaned plans the deletion of accounts missing locally. No
+// account is deleted when the ledger has invalid rows without a valid id.
+func WithDeleteOrphaned() ReconcilerOption {
+	return func(reconciler *Reconciler) {
+		reconciler.deleteOrphaned = true
+	}
+}
+
+// WithApply executes the planned actions. Without it the reconciler runs
+// in dry-run mode and never modifies remote accounts.
+func WithApply() ReconcilerOption {
+	return func(reconciler *Reconciler) {
+		reconciler.apply = true
+	}
+}
+
+// WithReconcileOrganisationID restricts the remote accounts to the ones of
+// the given organisation, so that accounts of other organisations are not
+// reported as missing locally.
+func WithReconcileOrganisationID(organisationID string) ReconcilerOption {
+	return func(reconciler *Reconciler) {
+		reconciler.organisationID = organisationID
+	}
+}
+
+func WithReconcilePageSize(pageSize int) ReconcilerOption {
+	return func(reconciler *Reconciler) {
+		if pageSize > 0 {
+			reconciler.pageSize = pageSize
+		}
+	}
+}
+
+// Reconcile reads every expected account from reader, compares them with the
+// remote accounts and returns one discrepancy per account, matching ones
+// included. Discrepancies follow the order of the reader, with accounts
+// missing locally at the end sorted by id.
+func (r *Reconciler) Reconcile(ctx context.Context, reader IReader) (ReconcileReport, error) {
+	report := ReconcileReport{}
+
+	remote := map[string]models.AccountData{}
+	err := walkAccounts(ctx, r.client, r.pageSize, func(data models.AccountData) error {
+		if r.organisationID == "" || data.OrganisationID == r.organisationID {
+			remote[data.ID] = data
+		}
+		return nil
+	})
+	if err != nil {
+		return report, err
+	}
+
+	local := map[string]struct{}{}
+	// ids of invalid rows are never orphaned, their remote accounts are kept
+	invalid := map[string]struct{}{}
+	// an invalid row without a valid id could be any remote account, so no
+	// account is deleted
+	unidentified := false
+
+	for {
+		row, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return report, err
+		}
+
+		discrepancy := r.compare(row, remote, local)
+		if discrepancy.Classification == ClassificationInvalid {
+			if _, parseErr := uuid.Parse(row.Data.ID); parseErr == nil {
+				invalid[row.Data.ID] = struct{}{}
+			} else {
+				unidentified = true
+			}
+		}
+
+		if discrepancy.Classification == ClassificationMissingRemotely && r.createMissing {
+			discrepancy.Action = ActionCreate
+			r.applyAction(ctx, &discrepancy, row.Data)
+		}
+
+		report.add(discrepancy)
+	}
+
+	orphaned := make([]string, 0)
+	for id := range remote {
+		_, exists := local[id]
+		_, referenced := invalid[id]
+		if !exists && !referenced {
+			orphaned = append(orphaned, id)
+		}
+	}
+	sort.Strings(orphaned)
+
+	for _, id := range orphaned {
+		discrepancy := Discrepancy{
+			AccountID:      id,
+			Classification: ClassificationMissingLocally,
+		}
+
+		switch {
+		case r.deleteOrphaned && unidentified:
+			discrepancy.Err = fmt.Errorf("%w: account missing locally not deleted", ErrUnidentifiedRow)
+		case r.deleteOrphaned:
+			discrepancy.Action = ActionDelete
+			r.applyAction(ctx, &discrepancy, remote[id])
+		}
+
+		report.add(discrepancy)
+	}
+
+	return report, ctx.Err()
+}
+
+func (r *Reconciler) compare(row Row, remote map[string]models.AccountData, local map[string]struct{}) Discrepancy {
+	discrepancy := Discrepancy{
+		Row:       row.Number,
+		AccountID: row.Data.ID,
+	}
+
+	err := row.Err
+	if err == nil {
+		if _, duplicated := local[row.Data.ID]; duplicated {
+			err = fmt.Errorf("%w: duplicated id %s", ErrInvalidAccount, row.Data.ID)
+		}
+	}
+	if err == nil {
+		err = r.validate(row.Data)
+	}
+	if err != nil {
+		discrepancy.Classification = ClassificationInvalid
+		discrepancy.Err = err
+		return discrepancy
+	}
+
+	local[row.Data.ID] = struct{}{}
+
+	remoteData, exists := remote[row.Data.ID]
+	if !exists {
+		discrepancy.Classification = ClassificationMissingRemotely
+		return discrepancy
+	}
+
+	discrepancy.Diffs = diffAccounts(row.Data, remoteData)
+	if len(discrepancy.Diffs) > 0 {
+		discrepancy.Classification = ClassificationDivergent
+	} else {
+		discrepancy.Classification = ClassificationMatching
+	}
+
+	return discrepancy
+}
+
+func (r *Reconciler) applyAction(ctx context.Context, discrepancy *Discrepancy, data models.AccountData) {
+	if !r.apply || ctx.Err() != nil {
+		return
+	}
+
+	var err error
+	switch discrepancy.Action {
+	case ActionCreate:
+		_, err = r.client.CreateAccount(*new(models.Account).WithData(data), accounts.WithContext(ctx))
+	case ActionDelete:
+		var version int64
+		if data.Version != nil {
+			version = *data.Version
+		}
+		err = r.client.DeleteAccount(data.ID, version, accounts.WithContext(ctx))
+	}
+
+	discrepancy.Applied = err == nil
+	discrepancy.Err = err
+}
+
+func (r *ReconcileReport) add(discrepancy Discrepancy) {
+	r.Discrepancies = append(r.Discrepancies, discrepancy)
+
+	switch discrepancy.Classification {
+	case ClassificationMatching:
+		r.Summary.Matching++
+	case ClassificationDivergent:
+		r.Summary.Divergent++
+	case ClassificationMissingRemotely:
+		r.Summary.MissingRemotely++
+	case ClassificationMissingLocally:
+		r.Summary.MissingLocally++
+	case ClassificationInvalid:
+		r.Summary.Invalid++
+		return
+	}
+
+	switch {
+	case discrepancy.Err != nil:
+		r.Summary.Failed++
+	case discrepancy.Applied && discrepancy.Action == ActionCreate:
+		r.Summary.Created++
+	case discrepancy.Applied && discrepancy.Action == ActionDelete:
+		r.Summary.Deleted++
+	}
+}
+
+func diffAccounts(local models.AccountData, remote models.AccountData) []FieldDiff {
+	localValues := fieldValues(local, Fields, false)
+	remoteValues := fieldValues(remote, Fields, false)
+
+	var diffs []FieldDiff
+	for i, field := range Fields {
+		// the version is owned by the api and is not compared
+		if field == FieldVersion {
+			continue
+		}
+
+		if localValues[i] != remoteValues[i] {
+			diffs = append(diffs, FieldDiff{
+				Field:  field,
+				Local:  localValues[i],
+				Remote: remoteValues[i],
+			})
+		}
+	}
+
+	return diffs
+}
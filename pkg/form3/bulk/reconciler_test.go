@@ -0,0 +1,205 @@
+package bulk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/form3mock"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconciler_Reconcile(t *testing.T) {
+	organisationID := uuid.NewString()
+	matchingID := uuid.NewString()
+	divergentID := uuid.NewString()
+	missingRemotelyID := uuid.NewString()
+	missingLocallyID := uuid.NewString()
+	invalidID := uuid.NewString()
+
+	tests := []struct {
+		name            string
+		opts            []ReconcilerOption
+		expectedActions map[string]Action
+		expectedSummary ReconcileSummary
+		expectedRemote  []string
+	}{
+		{
+			name: "given a dry run" +
+				"when reconciling accounts" +
+				"then classify accounts and plan actions without applying them",
+			opts: []ReconcilerOption{WithCreateMissing(), WithDeleteOrphaned()},
+			expectedActions: map[string]Action{
+				missingRemotelyID: ActionCreate,
+				missingLocallyID:  ActionDelete,
+			},
+			expectedSummary: ReconcileSummary{Matching: 1, Divergent: 1, MissingRemotely: 1, MissingLocally: 1, Invalid: 1},
+			expectedRemote:  []string{matchingID, divergentID, missingLocallyID},
+		},
+		{
+			name: "given apply mode" +
+				"when reconciling accounts" +
+				"then create missing and delete orphaned accounts",
+			opts: []ReconcilerOption{WithCreateMissing(), WithDeleteOrphaned(), WithApply()},
+			expectedActions: map[string]Action{
+				missingRemotelyID: ActionCreate,
+				missingLocallyID:  ActionDelete,
+			},
+			expectedSummary: ReconcileSummary{Matching: 1, Divergent: 1, MissingRemotely: 1, MissingLocally: 1, Invalid: 1,
+				Created: 1, Deleted: 1},
+			expectedRemote: []string{matchingID, divergentID, missingRemotelyID},
+		},
+		{
+			name: "given apply mode without fixes" +
+				"when reconciling accounts" +
+				"then only classify accounts",
+			opts:            []ReconcilerOption{WithApply()},
+			expectedActions: map[string]Action{},
+			expectedSummary: ReconcileSummary{Matching: 1, Divergent: 1, MissingRemotely: 1, MissingLocally: 1, Invalid: 1},
+			expectedRemote:  []string{matchingID, divergentID, missingLocallyID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			client := form3mock.NewStatefulClient()
+			createAccount(t, client, *newExportAccount(matchingID, organisationID, "name"))
+			createAccount(t, client, *newExportAccount(divergentID, organisationID, "remote name"))
+			createAccount(t, client, *newExportAccount(missingLocallyID, organisationID, "name"))
+
+			invalid := *newExportAccount(invalidID, organisationID, "name")
+			invalid.Attributes.WithCountry("")
+
+			local := []models.AccountData{
+				*newExportAccount(matchingID, organisationID, "name"),
+				*newExportAccount(divergentID, organisationID, "local name"),
+				*newExportAccount(missingRemotelyID, organisationID, "name"),
+				invalid,
+			}
+
+			reconciler := NewReconciler(client, append(tt.opts, WithReconcilePageSize(2))...)
+
+			// Act
+			report, err := reconciler.Reconcile(context.Background(), NewSliceReader(local))
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSummary, report.Summary)
+
+			classifications := map[string]Classification{}
+			actions := map[string]Action{}
+			for _, discrepancy := range report.Discrepancies {
+				classifications[discrepancy.AccountID] = discrepancy.Classification
+				if discrepancy.Action != ActionNone {
+					actions[discrepancy.AccountID] = discrepancy.Action
+				}
+			}
+
+			assert.Equal(t, map[string]Classification{
+				matchingID:        ClassificationMatching,
+				divergentID:       ClassificationDivergent,
+				missingRemotelyID: ClassificationMissingRemotely,
+				missingLocallyID:  ClassificationMissingLocally,
+				invalidID:         ClassificationInvalid,
+			}, classifications)
+			assert.Equal(t, tt.expectedActions, actions)
+			assert.Equal(t, []FieldDiff{{Field: FieldName, Local: "local name", Remote: "remote name"}},
+				report.Discrepancies[1].Diffs)
+
+			list, err := client.ListAccounts(0, 100)
+			require.NoError(t, err)
+
+			var remote []string
+			for _, data := range list.Data {
+				remote = append(remote, data.ID)
+			}
+			assert.ElementsMatch(t, tt.expectedRemote, remote)
+		})
+	}
+}
+
+func TestReconciler_Reconcile_invalidRow(t *testing.T) {
+	// Arrange
+	client := form3mock.NewStatefulClient()
+	organisationID := uuid.NewString()
+	account := *newExportAccount(uuid.NewString(), organisationID, "name")
+	createAccount(t, client, account)
+
+	invalid := *newExportAccount(account.ID, organisationID, "name")
+	invalid.Attributes.WithCountry("")
+
+	reconciler := NewReconciler(client, WithDeleteOrphaned(), WithApply())
+
+	// Act
+	report, err := reconciler.Reconcile(context.Background(), NewSliceReader([]models.AccountData{invalid}))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, ReconcileSummary{Invalid: 1}, report.Summary)
+
+	_, err = client.FetchAccount(account.ID)
+	assert.NoError(t, err)
+}
+
+func TestReconciler_Reconcile_unidentifiedRow(t *testing.T) {
+	// Arrange
+	client := form3mock.NewStatefulClient()
+	account := *newExportAccount(uuid.NewString(), uuid.NewString(), "name")
+	createAccount(t, client, account)
+
+	reader, err := NewCSVReader(strings.NewReader("id,country\n\"unterminated,GB\n"), DefaultColumnMapping())
+	require.NoError(t, err)
+
+	reconciler := NewReconciler(client, WithDeleteOrphaned(), WithApply())
+
+	// Act
+	report, err := reconciler.Reconcile(context.Background(), reader)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, ReconcileSummary{MissingLocally: 1, Invalid: 1, Failed: 1}, report.Summary)
+	assert.ErrorIs(t, report.Discrepancies[1].Err, ErrUnidentifiedRow)
+	assert.Equal(t, ActionNone, report.Discrepancies[1].Action)
+
+	_, err = client.FetchAccount(account.ID)
+	assert.NoError(t, err)
+}
+
+func TestReconciler_Reconcile_organisation(t *testing.T) {
+	// Arrange
+	client := form3mock.NewStatefulClient()
+	organisationID := uuid.NewString()
+	createAccount(t, client, *newExportAccount(uuid.NewString(), uuid.NewString(), "name"))
+
+	reconciler := NewReconciler(client, WithReconcileOrganisationID(organisationID))
+
+	// Act
+	report, err := reconciler.Reconcile(context.Background(), NewSliceReader(nil))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, report.Discrepancies)
+}
+
+func TestChannelReader_Next(t *testing.T) {
+	// Arrange
+	accounts := make(chan models.AccountData, 2)
+	accounts <- *newExportAccount(uuid.NewString(), uuid.NewString(), "name")
+	accounts <- *newExportAccount(uuid.NewString(), uuid.NewString(), "name")
+	close(accounts)
+
+	client := form3mock.NewStatefulClient()
+	reconciler := NewReconciler(client)
+
+	// Act
+	report, err := reconciler.Reconcile(context.Background(), NewChannelReader(context.Background(), accounts))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Summary.MissingRemotely)
+	assert.Equal(t, 2, report.Discrepancies[1].Row)
+}
@@ -0,0 +1,52 @@
+package bulk
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+type (
+	IReportWriter interface {
+		Write(Result) error
+	}
+
+	// CSVReportWriter writes one csv line per imported row.
+	CSVReportWriter struct {
+		writer        *csv.Writer
+		headerWritten bool
+	}
+)
+
+func NewCSVReportWriter(w io.Writer) *CSVReportWriter {
+	return &CSVReportWriter{writer: csv.NewWriter(w)}
+}
+
+// SkipHeader is used when appending to an existing report, e.g. on resume.
+func (w *CSVReportWriter) SkipHeader() *CSVReportWriter {
+	w.headerWritten = true
+	return w
+}
+
+func (w *CSVReportWriter) Write(result Result) error {
+	if !w.headerWritten {
+		if err := w.writer.Write([]string{"row", "account_id", "status", "reason"}); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+
+	err := w.writer.Write([]string{
+		strconv.Itoa(result.Row),
+		result.AccountID,
+		string(result.Status),
+		result.Reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	w.writer.Flush()
+
+	return w.writer.Error()
+}
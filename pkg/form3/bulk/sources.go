@@ -0,0 +1,57 @@
+package bulk
+
+import (
+	"context"
+	"io"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+)
+
+type (
+	SliceReader struct {
+		accounts []models.AccountData
+		number   int
+	}
+
+	ChannelReader struct {
+		ctx      context.Context
+		accounts <-chan models.AccountData
+		number   int
+	}
+)
+
+// NewSliceReader reads accounts already held in memory.
+func NewSliceReader(accounts []models.AccountData) *SliceReader {
+	return &SliceReader{accounts: accounts}
+}
+
+func (r *SliceReader) Next() (Row, error) {
+	if r.number >= len(r.accounts) {
+		return Row{}, io.EOF
+	}
+
+	r.number++
+
+	return Row{Number: r.number, Data: r.accounts[r.number-1]}, nil
+}
+
+// NewChannelReader reads accounts until the channel is closed or ctx is
+// cancelled.
+func NewChannelReader(ctx context.Context, accounts <-chan models.AccountData) *ChannelReader {
+	return &ChannelReader{ctx: ctx, accounts: accounts}
+}
+
+func (r *ChannelReader) Next() (Row, error) {
+	select {
+	case data, ok := <-r.accounts:
+		if !ok {
+			return Row{}, io.EOF
+		}
+
+		r.number++
+
+		return Row{Number: r.number, Data: data}, nil
+	case <-r.ctx.Done():
+		return Row{}, r.ctx.Err()
+	}
+}
@@ -0,0 +1,240 @@
+package bulk
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/logging"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+)
+
+var (
+	_fieldValues = map[string]func(models.AccountData, models.AccountAttributes) string{
+		FieldID:             func(d models.AccountData, _ models.AccountAttributes) string { return d.ID },
+		FieldOrganisationID: func(d models.AccountData, _ models.AccountAttributes) string { return d.OrganisationID },
+		FieldType:           func(d models.AccountData, _ models.AccountAttributes) string { return d.Type },
+		FieldVersion: func(d models.AccountData, _ models.AccountAttributes) string {
+			if d.Version == nil {
+				return ""
+			}
+			return strconv.FormatInt(*d.Version, 10)
+		},
+		FieldAccountClassification: func(_ models.AccountData, a models.AccountAttributes) string {
+			return stringValue(a.AccountClassification)
+		},
+		FieldAccountMatchingOptOut: func(_ models.AccountData, a models.AccountAttributes) string {
+			return boolValue(a.AccountMatchingOptOut)
+		},
+		FieldAccountNumber: func(_ models.AccountData, a models.AccountAttributes) string { return a.AccountNumber },
+		FieldAlternativeNames: func(_ models.AccountData, a models.AccountAttributes) string {
+			return strings.Join(a.AlternativeNames, ListSeparator)
+		},
+		FieldBankID:       func(_ models.AccountData, a models.AccountAttributes) string { return a.BankID },
+		FieldBankIDCode:   func(_ models.AccountData, a models.AccountAttributes) string { return a.BankIDCode },
+		FieldBaseCurrency: func(_ models.AccountData, a models.AccountAttributes) string { return a.BaseCurrency },
+		FieldBic:          func(_ models.AccountData, a models.AccountAttributes) string { return a.Bic },
+		FieldCountry:      func(_ models.AccountData, a models.AccountAttributes) string { return stringValue(a.Country) },
+		FieldIban:         func(_ models.AccountData, a models.AccountAttributes) string { return a.Iban },
+		FieldJointAccount: func(_ models.AccountData, a models.AccountAttributes) string { return boolValue(a.JointAccount) },
+		FieldName: func(_ models.AccountData, a models.AccountAttributes) string {
+			return strings.Join(a.Name, ListSeparator)
+		},
+		FieldSecondaryIdentification: func(_ models.AccountData, a models.AccountAttributes) string {
+			return a.SecondaryIdentification
+		},
+		FieldStatus:   func(_ models.AccountData, a models.AccountAttributes) string { return stringValue(a.Status) },
+		FieldSwitched: func(_ models.AccountData, a models.AccountAttributes) string { return boolValue(a.Switched) },
+	}
+)
+
+type (
+	accountWriter interface {
+		write(models.AccountData) error
+		flush() error
+	}
+
+	csvWriter struct {
+		writer        *csv.Writer
+		fields        []string
+		mask          bool
+		headerWritten bool
+	}
+
+	// ndjsonWriter writes accounts in the same json representation accepted
+	// by NDJSONReader, keeping only the selected fields.
+	ndjsonWriter struct {
+		writer io.Writer
+		fields map[string]struct{}
+		mask   bool
+	}
+
+	// columnarWriter writes a single json document with one array of values
+	// per field.
+	columnarWriter struct {
+		writer  io.Writer
+		fields  []string
+		mask    bool
+		columns map[string][]string
+		count   int
+	}
+
+	columnarDocument struct {
+		Fields  []string            `json:"fields"`
+		Count   int                 `json:"count"`
+		Columns map[string][]string `json:"columns"`
+	}
+)
+
+func newAccountWriter(w io.Writer, format Format, fields []string, mask bool) (accountWriter, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{writer: csv.NewWriter(w), fields: fields, mask: mask}, nil
+	case FormatNDJSON:
+		selected := make(map[string]struct{}, len(fields))
+		for _, field := range fields {
+			selected[field] = struct{}{}
+		}
+		return &ndjsonWriter{writer: w, fields: selected, mask: mask}, nil
+	case FormatColumnar:
+		columns := make(map[string][]string, len(fields))
+		for _, field := range fields {
+			columns[field] = []string{}
+		}
+		return &columnarWriter{writer: w, fields: fields, mask: mask, columns: columns}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+func (w *csvWriter) write(data models.AccountData) error {
+	if !w.headerWritten {
+		if err := w.writer.Write(w.fields); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+
+	return w.writer.Write(fieldValues(data, w.fields, w.mask))
+}
+
+func (w *csvWriter) flush() error {
+	if !w.headerWritten {
+		if err := w.writer.Write(w.fields); err != nil {
+			return err
+		}
+	}
+
+	w.writer.Flush()
+
+	return w.writer.Error()
+}
+
+func (w *ndjsonWriter) write(data models.AccountData) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	document := map[string]interface{}{}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return err
+	}
+
+	projected := map[string]interface{}{}
+	attributes := map[string]interface{}{}
+
+	for key, value := range document {
+		if _, selected := w.fields[key]; selected {
+			projected[key] = value
+		}
+	}
+
+	if documentAttributes, ok := document["attributes"].(map[string]interface{}); ok {
+		for key, value := range documentAttributes {
+			if _, selected := w.fields[key]; selected {
+				attributes[key] = value
+			}
+		}
+	}
+
+	if len(attributes) > 0 {
+		projected["attributes"] = attributes
+	}
+
+	line, err := json.Marshal(projected)
+	if err != nil {
+		return err
+	}
+
+	if w.mask {
+		line = logging.MaskJSON(line)
+	}
+
+	_, err = w.writer.Write(append(line, '\n'))
+
+	return err
+}
+
+func (w *ndjsonWriter) flush() error {
+	return nil
+}
+
+func (w *columnarWriter) write(data models.AccountData) error {
+	for i, value := range fieldValues(data, w.fields, w.mask) {
+		w.columns[w.fields[i]] = append(w.columns[w.fields[i]], value)
+	}
+
+	w.count++
+
+	return nil
+}
+
+func (w *columnarWriter) flush() error {
+	return json.NewEncoder(w.writer).Encode(columnarDocument{
+		Fields:  w.fields,
+		Count:   w.count,
+		Columns: w.columns,
+	})
+}
+
+func fieldValues(data models.AccountData, fields []string, mask bool) []string {
+	attributes := models.AccountAttributes{}
+	if data.Attributes != nil {
+		attributes = *data.Attributes
+	}
+
+	values := make([]string, len(fields))
+	for i, field := range fields {
+		values[i] = _fieldValues[field](data, attributes)
+
+		if mask && values[i] != "" && logging.IsSensitive(field) {
+			values[i] = logging.Redacted
+		}
+	}
+
+	return values
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+func boolValue(value *bool) string {
+	if value == nil {
+		return ""
+	}
+
+	return strconv.FormatBool(*value)
+}
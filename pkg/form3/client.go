@@ -2,26 +2,67 @@ package form3
 
 import (
 	"fmt"
+
 	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/health"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/organisations"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/payments"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/subscriptions"
 )
 
 type IClient interface {
 	accounts.IAccountClient
+	health.IHealthClient
+	organisations.IOrganisationClient
+	payments.IPaymentClient
+	subscriptions.ISubscriptionClient
 }
 
 type client struct {
 	accounts.IAccountClient
+	health.IHealthClient
+	organisations.IOrganisationClient
+	payments.IPaymentClient
+	subscriptions.ISubscriptionClient
 }
 
-func NewClient(env Environment) (IClient, error) {
-	host, exists := _hostByEnvironment[env]
+func NewClient(env Environment, opts ...Option) (IClient, error) {
+	environment, exists := LookupEnvironment(env)
 	if !exists {
-		return nil, ErrUnknownEnvironment
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEnvironment, env)
+	}
+
+	options := defaultClientOptions()
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	host := environment.Host
+	if options.host != "" {
+		host = options.host
 	}
 
-	baseUrl := fmt.Sprintf("%s/%s", host, _apiVersion)
+	baseUrl := fmt.Sprintf("%s/%s", host, environment.APIVersion)
+
+	httpClient, err := newHttpClient(options)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceOptions := options.serviceOptions(httpClient)
+
+	accountClient := accounts.NewAccountClient(baseUrl, serviceOptions...)
+
+	if environment.Production && !options.destructiveOperations {
+		accountClient = destructiveOperationGuard{IAccountClient: accountClient}
+	}
 
 	return client{
-		IAccountClient: accounts.NewAccountClient(baseUrl),
+		IAccountClient:      accountClient,
+		IHealthClient:       health.NewHealthClient(baseUrl, serviceOptions...),
+		IOrganisationClient: organisations.NewOrganisationClient(baseUrl, serviceOptions...),
+		IPaymentClient:      payments.NewPaymentClient(baseUrl, serviceOptions...),
+		ISubscriptionClient: subscriptions.NewSubscriptionClient(baseUrl, serviceOptions...),
 	}, nil
 }
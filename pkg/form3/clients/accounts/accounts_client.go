@@ -2,169 +2,123 @@ package accounts
 
 import (
 	"fmt"
-	"io/ioutil"
 	"net/http"
-	"time"
 
 	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/jsonapi"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
 	"github.com/francorosatti/form3-api-client/pkg/form3/models"
 )
 
 type IAccountClient interface {
-	CreateAccount(models.Account) (models.Account, error)
-	FetchAccount(accountID string) (models.Account, error)
-	DeleteAccount(accountID string, version int64) error
+	CreateAccount(account models.Account, opts ...CallOption) (models.Account, error)
+	FetchAccount(accountID string, opts ...CallOption) (models.Account, error)
+	DeleteAccount(accountID string, version int64, opts ...CallOption) error
+	ListAccounts(pageNumber int, pageSize int, opts ...CallOption) (models.AccountList, error)
 }
 
 type accountClient struct {
+	service   service.Service
 	endpoints map[string]endpoints.IEndpoint
 }
 
-func NewAccountClient(baseUrl string) IAccountClient {
-	endpoints := createEndpoints(baseUrl)
+func NewAccountClient(baseUrl string, opts ...Option) IAccountClient {
+	s := service.New(_tracerName, _errors, opts)
 
 	return accountClient{
-		endpoints: endpoints,
+		service: s,
+		endpoints: map[string]endpoints.IEndpoint{
+			_endpointCreateAccount: s.Endpoint(
+				_endpointCreateAccount,
+				fmt.Sprintf("%s/organisation/accounts", baseUrl),
+				http.MethodPost,
+			),
+			_endpointFetchAccount: s.Endpoint(
+				_endpointFetchAccount,
+				fmt.Sprintf("%s/organisation/accounts/{id}", baseUrl),
+				http.MethodGet,
+			),
+			_endpointDeleteAccount: s.Endpoint(
+				_endpointDeleteAccount,
+				fmt.Sprintf("%s/organisation/accounts/{id}", baseUrl),
+				http.MethodDelete,
+			),
+			_endpointListAccounts: s.Endpoint(
+				_endpointListAccounts,
+				fmt.Sprintf("%s/organisation/accounts", baseUrl),
+				http.MethodGet,
+			),
+		},
 	}
 }
 
-func createEndpoints(baseUrl string) map[string]endpoints.IEndpoint {
-	return map[string]endpoints.IEndpoint{
-		_endpointCreateAccount: endpoints.NewEndpoint(
-			&http.Client{Timeout: 3 * time.Second},
-			fmt.Sprintf("%s/organisation/accounts", baseUrl),
-			http.MethodPost,
-		),
-		_endpointFetchAccount: endpoints.NewEndpoint(
-			&http.Client{Timeout: 3 * time.Second},
-			fmt.Sprintf("%s/organisation/accounts/{id}", baseUrl),
-			http.MethodGet,
-		),
-		_endpointDeleteAccount: endpoints.NewEndpoint(
-			&http.Client{Timeout: 3 * time.Second},
-			fmt.Sprintf("%s/organisation/accounts/{id}", baseUrl),
-			http.MethodDelete,
-		),
-	}
-}
+func (client accountClient) CreateAccount(account models.Account, opts ...CallOption) (_ models.Account, err error) {
+	options := service.NewCallOptions(opts)
 
-func (client accountClient) CreateAccount(account models.Account) (models.Account, error) {
-	accountBytes, err := accountDataToJson(account)
-	if err != nil {
-		return models.Account{}, err
+	if account.Data != nil && account.Data.OrganisationID == "" && client.service.OrganisationID() != "" {
+		data := *account.Data
+		account.Data = data.WithOrganisationID(client.service.OrganisationID())
 	}
 
-	response, err := client.requestCreateAccount(accountBytes)
-	if err != nil {
-		return models.Account{}, err
-	}
-
-	return jsonToAccountData(response)
-}
-
-func (client accountClient) FetchAccount(accountID string) (models.Account, error) {
-	if accountID == "" {
-		return models.Account{}, ErrAccountInvalidParameters
-	}
+	ctx, span := client.service.Start(options.Context(), _spanCreateAccount, accountAttributes(account)...)
+	defer func() { client.service.End(span, _endpointCreateAccount, err) }()
 
-	response, err := client.requestFetchAccount(accountID)
+	body, err := client.service.Executor(options).Encode(account)
 	if err != nil {
 		return models.Account{}, err
 	}
 
-	return jsonToAccountData(response)
+	return jsonapi.Call[models.Account](ctx, client.service.Executor(options), client.endpoints[_endpointCreateAccount], options.RequestOptions(endpoints.WithBody(body))...)
 }
 
-func (client accountClient) DeleteAccount(accountID string, version int64) error {
-	if accountID == "" {
-		return ErrAccountInvalidParameters
-	}
-
-	return client.requestDeleteAccount(accountID, version)
-}
-
-func (client accountClient) requestCreateAccount(accountBody []byte) ([]byte, error) {
-	endpoint := client.endpoints[_endpointCreateAccount]
-
-	requestBody := endpoints.WithBody(accountBody)
-
-	res, err := endpoint.Do(requestBody)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", errDoRequest, err)
-	}
+func (client accountClient) FetchAccount(accountID string, opts ...CallOption) (_ models.Account, err error) {
+	options := service.NewCallOptions(opts)
 
-	defer res.Body.Close()
+	ctx, span := client.service.Start(options.Context(), _spanFetchAccount, _attributeAccountID.String(accountID))
+	defer func() { client.service.End(span, _endpointFetchAccount, err) }()
 
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", errResponseReadBody, err)
+	if accountID == "" {
+		return models.Account{}, ErrAccountInvalidParameters
 	}
 
-	if err = handleStatusCode(res.StatusCode, body); err != nil {
-		return nil, err
+	account, err := jsonapi.Call[models.Account](ctx, client.service.Executor(options), client.endpoints[_endpointFetchAccount], options.RequestOptions(endpoints.WithParam(_paramID, accountID))...)
+	if err == nil && account.Data != nil {
+		span.SetAttributes(service.AttributeOrganisationID.String(account.Data.OrganisationID))
 	}
 
-	return body, nil
+	return account, err
 }
 
-func (client accountClient) requestFetchAccount(id string) ([]byte, error) {
-	endpoint := client.endpoints[_endpointFetchAccount]
+func (client accountClient) DeleteAccount(accountID string, version int64, opts ...CallOption) (err error) {
+	options := service.NewCallOptions(opts)
 
-	params := endpoints.WithParam(_paramID, id)
+	ctx, span := client.service.Start(options.Context(), _spanDeleteAccount, _attributeAccountID.String(accountID))
+	defer func() { client.service.End(span, _endpointDeleteAccount, err) }()
 
-	res, err := endpoint.Do(params)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", errDoRequest, err)
-	}
-
-	defer res.Body.Close()
-
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", errResponseReadBody, err)
+	if accountID == "" {
+		return ErrAccountInvalidParameters
 	}
 
-	if err = handleStatusCode(res.StatusCode, body); err != nil {
-		return nil, err
-	}
+	err = client.service.Executor(options).Do(ctx, client.endpoints[_endpointDeleteAccount], options.RequestOptions(
+		endpoints.WithParam(_paramID, accountID),
+		endpoints.WithQueryParam(_queryVersion, fmt.Sprintf("%d", version)),
+	)...)
 
-	return body, nil
+	return err
 }
 
-func (client accountClient) requestDeleteAccount(id string, version int64) error {
-	endpoint := client.endpoints[_endpointDeleteAccount]
-
-	params := endpoints.WithParam(_paramID, id)
-	query := endpoints.WithQueryParam(_queryVersion, fmt.Sprintf("%d", version))
-
-	res, err := endpoint.Do(params, query)
-	if err != nil {
-		return fmt.Errorf("%w: %s", errDoRequest, err)
-	}
+func (client accountClient) ListAccounts(pageNumber int, pageSize int, opts ...CallOption) (_ models.AccountList, err error) {
+	options := service.NewCallOptions(opts)
 
-	defer res.Body.Close()
+	ctx, span := client.service.Start(options.Context(), _spanListAccounts)
+	defer func() { client.service.End(span, _endpointListAccounts, err) }()
 
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return fmt.Errorf("%w: %s", errResponseReadBody, err)
+	if pageNumber < 0 || pageSize <= 0 {
+		return models.AccountList{}, ErrAccountInvalidParameters
 	}
 
-	return handleStatusCode(res.StatusCode, body)
-}
-
-func handleStatusCode(statusCode int, body []byte) error {
-	if statusCode < 300 {
-		return nil
-	}
-
-	switch statusCode {
-	case 400:
-		return fmt.Errorf("%w: %s", ErrAccountBadRequest, string(body))
-	case 404:
-		return ErrAccountNotFound
-	case 409:
-		return ErrAccountConflict
-	default:
-		return fmt.Errorf("%w: status code %d", errResponseStatusCode, statusCode)
-	}
+	return jsonapi.Call[models.AccountList](ctx, client.service.Executor(options), client.endpoints[_endpointListAccounts], options.RequestOptions(
+		endpoints.WithQueryParam(_queryPageNumber, fmt.Sprintf("%d", pageNumber)),
+		endpoints.WithQueryParam(_queryPageSize, fmt.Sprintf("%d", pageSize)),
+	)...)
 }
@@ -1,13 +1,19 @@
 package accounts
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/francorosatti/form3-api-client/pkg/form3/codec"
 	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/metadata"
 	"github.com/francorosatti/form3-api-client/pkg/form3/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -37,6 +43,8 @@ func TestNewAccountClient(t *testing.T) {
 	assert.True(t, exists)
 	_, exists = client.endpoints[_endpointDeleteAccount]
 	assert.True(t, exists)
+	_, exists = client.endpoints[_endpointListAccounts]
+	assert.True(t, exists)
 }
 
 func Test_accountClient_CreateAccount(t *testing.T) {
@@ -81,7 +89,7 @@ func Test_accountClient_CreateAccount(t *testing.T) {
 					return endpoint
 				}(),
 			},
-			expectedErr: errResponseUnmarshal,
+			expectedErr: service.ErrResponseUnmarshal,
 		},
 		{
 			name: "given any input" +
@@ -113,6 +121,7 @@ func Test_accountClient_CreateAccount(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Act
 			client := accountClient{
+				service: service.New(_tracerName, _errors, nil),
 				endpoints: map[string]endpoints.IEndpoint{
 					_endpointCreateAccount: tt.fields.endpoint,
 				},
@@ -128,6 +137,52 @@ func Test_accountClient_CreateAccount(t *testing.T) {
 	}
 }
 
+func Test_accountClient_CreateAccount_organisationID(t *testing.T) {
+	tests := []struct {
+		name                   string
+		organisationID         string
+		expectedOrganisationID string
+	}{
+		{
+			name: "given an account without organisation" +
+				"when creating it" +
+				"then use the client organisation",
+			expectedOrganisationID: "default_organisation",
+		},
+		{
+			name: "given an account with organisation" +
+				"when creating it" +
+				"then keep its organisation",
+			organisationID:         "account_organisation",
+			expectedOrganisationID: "account_organisation",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var sent models.Account
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				_ = json.Unmarshal(body, &sent)
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write(body)
+			}))
+			defer server.Close()
+
+			client := NewAccountClient(server.URL, WithOrganisationID("default_organisation"))
+			account := *new(models.Account).WithData(*new(models.AccountData).WithID("id").WithOrganisationID(tt.organisationID))
+
+			// Act
+			_, err := client.CreateAccount(account)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOrganisationID, sent.Data.OrganisationID)
+			assert.Equal(t, tt.organisationID, account.Data.OrganisationID)
+		})
+	}
+}
+
 func Test_accountClient_FetchAccount(t *testing.T) {
 	type fields struct {
 		endpoint endpoints.IEndpoint
@@ -185,6 +240,7 @@ func Test_accountClient_FetchAccount(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange
 			client := accountClient{
+				service: service.New(_tracerName, _errors, nil),
 				endpoints: map[string]endpoints.IEndpoint{
 					_endpointFetchAccount: tt.fields.endpoint,
 				},
@@ -200,14 +256,85 @@ func Test_accountClient_FetchAccount(t *testing.T) {
 	}
 }
 
-func Test_accountClient_requestCreateAccount(t *testing.T) {
+func Test_accountClient_ListAccounts(t *testing.T) {
+	type fields struct {
+		endpoint endpoints.IEndpoint
+	}
+	type args struct {
+		pageNumber int
+		pageSize   int
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		expectedOut models.AccountList
+		expectedErr error
+	}{
+		{
+			name: "given an invalid page size" +
+				"when listing accounts" +
+				"then return error",
+			args: args{
+				pageNumber: 0,
+				pageSize:   0,
+			},
+			expectedErr: ErrAccountInvalidParameters,
+		},
+		{
+			name: "given valid pagination" +
+				"when endpoint responds status ok" +
+				"then return response with models",
+			fields: fields{
+				endpoint: func() endpoints.IEndpoint {
+					endpoint := &endpointMock{}
+					endpoint.On("Do", mock.Anything).
+						Return(&http.Response{
+							StatusCode: 200,
+							Body:       io.NopCloser(strings.NewReader(`{"data":[{"id":"id"}],"links":{"next":"next"}}`)),
+						}, nil)
+					return endpoint
+				}(),
+			},
+			args: args{
+				pageNumber: 0,
+				pageSize:   100,
+			},
+			expectedErr: nil,
+			expectedOut: models.AccountList{
+				Data:  []models.AccountData{{ID: "id"}},
+				Links: &models.Links{Next: "next"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			client := accountClient{
+				service: service.New(_tracerName, _errors, nil),
+				endpoints: map[string]endpoints.IEndpoint{
+					_endpointListAccounts: tt.fields.endpoint,
+				},
+			}
+
+			// Act
+			got, err := client.ListAccounts(tt.args.pageNumber, tt.args.pageSize)
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+			assert.Equal(t, tt.expectedOut, got)
+		})
+	}
+}
+
+func Test_accountClient_CreateAccount_response(t *testing.T) {
 	type fields struct {
 		endpoint endpoints.IEndpoint
 	}
 	tests := []struct {
 		name        string
 		fields      fields
-		expectedOut []byte
+		expectedOut models.Account
 		expectedErr error
 	}{
 		{
@@ -222,7 +349,7 @@ func Test_accountClient_requestCreateAccount(t *testing.T) {
 					return endpoint
 				}(),
 			},
-			expectedErr: errDoRequest,
+			expectedErr: service.ErrDoRequest,
 		},
 		{
 			name: "given any input" +
@@ -257,20 +384,21 @@ func Test_accountClient_requestCreateAccount(t *testing.T) {
 				}(),
 			},
 			expectedErr: nil,
-			expectedOut: []byte("{}"),
+			expectedOut: models.Account{},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange
 			client := accountClient{
+				service: service.New(_tracerName, _errors, nil),
 				endpoints: map[string]endpoints.IEndpoint{
 					_endpointCreateAccount: tt.fields.endpoint,
 				},
 			}
 
 			// Act
-			got, err := client.requestCreateAccount([]byte("anything"))
+			got, err := client.CreateAccount(models.Account{})
 
 			// Assert
 			assert.True(t, errors.Is(err, tt.expectedErr))
@@ -279,7 +407,7 @@ func Test_accountClient_requestCreateAccount(t *testing.T) {
 	}
 }
 
-func Test_accountClient_requestDeleteAccount(t *testing.T) {
+func Test_accountClient_DeleteAccount(t *testing.T) {
 	type fields struct {
 		endpoint endpoints.IEndpoint
 	}
@@ -300,7 +428,7 @@ func Test_accountClient_requestDeleteAccount(t *testing.T) {
 					return endpoint
 				}(),
 			},
-			expectedErr: errDoRequest,
+			expectedErr: service.ErrDoRequest,
 		},
 		{
 			name: "given any input" +
@@ -341,13 +469,14 @@ func Test_accountClient_requestDeleteAccount(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Act
 			client := accountClient{
+				service: service.New(_tracerName, _errors, nil),
 				endpoints: map[string]endpoints.IEndpoint{
 					_endpointDeleteAccount: tt.fields.endpoint,
 				},
 			}
 
 			// Act
-			err := client.requestDeleteAccount("any_id", 0)
+			err := client.DeleteAccount("any_id", 0)
 
 			// Assert
 			assert.True(t, errors.Is(err, tt.expectedErr))
@@ -355,14 +484,14 @@ func Test_accountClient_requestDeleteAccount(t *testing.T) {
 	}
 }
 
-func Test_accountClient_requestFetchAccount(t *testing.T) {
+func Test_accountClient_FetchAccount_response(t *testing.T) {
 	type fields struct {
 		endpoint endpoints.IEndpoint
 	}
 	tests := []struct {
 		name        string
 		fields      fields
-		expectedOut []byte
+		expectedOut models.Account
 		expectedErr error
 	}{
 		{
@@ -377,7 +506,7 @@ func Test_accountClient_requestFetchAccount(t *testing.T) {
 					return endpoint
 				}(),
 			},
-			expectedErr: errDoRequest,
+			expectedErr: service.ErrDoRequest,
 		},
 		{
 			name: "given any input" +
@@ -412,20 +541,21 @@ func Test_accountClient_requestFetchAccount(t *testing.T) {
 				}(),
 			},
 			expectedErr: nil,
-			expectedOut: []byte("{}"),
+			expectedOut: models.Account{},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange
 			client := accountClient{
+				service: service.New(_tracerName, _errors, nil),
 				endpoints: map[string]endpoints.IEndpoint{
 					_endpointFetchAccount: tt.fields.endpoint,
 				},
 			}
 
 			// Act
-			got, err := client.requestFetchAccount("any_id")
+			got, err := client.FetchAccount("any_id")
 
 			// Assert
 			assert.True(t, errors.Is(err, tt.expectedErr))
@@ -433,3 +563,137 @@ func Test_accountClient_requestFetchAccount(t *testing.T) {
 		})
 	}
 }
+
+func Test_accountClient_FetchAccount_codec(t *testing.T) {
+	tests := []struct {
+		name        string
+		codec       codec.ICodec
+		expectedErr error
+	}{
+		{
+			name: "given the default codec" +
+				"when endpoint responds an unknown field" +
+				"then ignore it",
+		},
+		{
+			name: "given a strict codec" +
+				"when endpoint responds an unknown field" +
+				"then return unmarshal error",
+			codec:       codec.JSON{DisallowUnknownFields: true},
+			expectedErr: service.ErrResponseUnmarshal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":{"id":"id","unknown":true}}`))
+			}))
+			defer server.Close()
+
+			client := NewAccountClient(server.URL, WithCodec(tt.codec))
+
+			// Act
+			_, err := client.FetchAccount("id")
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func Test_accountClient_CreateAccount_idempotencyKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []CallOption
+		expectedKey string
+	}{
+		{
+			name: "given no idempotency key" +
+				"when creating account" +
+				"then send a generated key",
+		},
+		{
+			name: "given an idempotency key" +
+				"when creating account" +
+				"then send the given key",
+			opts:        []CallOption{WithIdempotencyKey("customer-1")},
+			expectedKey: "customer-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var key string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				key = r.Header.Get(endpoints.HeaderIdempotencyKey)
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			client := NewAccountClient(server.URL)
+
+			// Act
+			_, err := client.CreateAccount(*new(models.Account).WithData(*new(models.AccountData).WithID("id")), tt.opts...)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.NotEmpty(t, key)
+			if tt.expectedKey != "" {
+				assert.Equal(t, tt.expectedKey, key)
+			}
+		})
+	}
+}
+
+func Test_accountClient_FetchAccount_metadata(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(endpoints.HeaderRequestID, "server_"+r.Header.Get(endpoints.HeaderRequestID))
+		_, _ = w.Write([]byte(`{"data":{"id":"id"}}`))
+	}))
+	defer server.Close()
+
+	client := NewAccountClient(server.URL)
+	ctx := metadata.WithRequestID(context.Background(), "request_id")
+
+	var meta metadata.Call
+
+	// Act
+	_, err := client.FetchAccount("id", WithContext(ctx), WithMetadata(&meta))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "request_id", meta.RequestID)
+	assert.Equal(t, "server_request_id", meta.ServerRequestID)
+	assert.Equal(t, http.StatusOK, meta.StatusCode)
+	assert.Equal(t, 1, meta.Attempts)
+}
+
+func Test_accountClient_callOptions_headers(t *testing.T) {
+	// Arrange
+	var headers []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewAccountClient(server.URL)
+	opts := []CallOption{WithHeader("X-Tenant", "tenant"), WithCacheBypass()}
+
+	// Act
+	_, createErr := client.CreateAccount(*new(models.Account).WithData(*new(models.AccountData).WithID("id")), opts...)
+	_, fetchErr := client.FetchAccount("id", opts...)
+	deleteErr := client.DeleteAccount("id", 0, opts...)
+
+	// Assert
+	assert.NoError(t, createErr)
+	assert.NoError(t, fetchErr)
+	assert.NoError(t, deleteErr)
+	for _, header := range headers {
+		assert.Equal(t, "tenant", header.Get("X-Tenant"))
+		assert.Equal(t, "no-cache", header.Get("Cache-Control"))
+	}
+	assert.Len(t, headers, 3)
+}
@@ -4,7 +4,10 @@ const (
 	_endpointCreateAccount = "create_account"
 	_endpointFetchAccount  = "fetch_account"
 	_endpointDeleteAccount = "delete_account"
+	_endpointListAccounts  = "list_accounts"
 
-	_paramID      = "id"
-	_queryVersion = "version"
+	_paramID         = "id"
+	_queryVersion    = "version"
+	_queryPageNumber = "page[number]"
+	_queryPageSize   = "page[size]"
 )
@@ -1,15 +1,21 @@
 package accounts
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+)
 
 var (
 	ErrAccountBadRequest        = errors.New("account bad request")
 	ErrAccountNotFound          = errors.New("account not found")
 	ErrAccountConflict          = errors.New("account conflict with version")
 	ErrAccountInvalidParameters = errors.New("invalid input parameters")
-
-	errDoRequest          = errors.New("error doing request")
-	errResponseReadBody   = errors.New("error reading response body")
-	errResponseStatusCode = errors.New("response error")
-	errResponseUnmarshal  = errors.New("error unmarshalling response")
 )
+
+var _errors = service.Errors{
+	InvalidParameters: ErrAccountInvalidParameters,
+	BadRequest:        ErrAccountBadRequest,
+	NotFound:          ErrAccountNotFound,
+	Conflict:          ErrAccountConflict,
+}
@@ -0,0 +1,27 @@
+package accounts
+
+import "github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+
+type (
+	Option = service.Option
+
+	CallOption = service.CallOption
+)
+
+var (
+	WithOrganisationID = service.WithOrganisationID
+	WithCodec          = service.WithCodec
+	WithHttpClient     = service.WithHttpClient
+	WithTracerProvider = service.WithTracerProvider
+	WithPropagator     = service.WithPropagator
+	WithMetrics        = service.WithMetrics
+	WithLogger         = service.WithLogger
+
+	WithContext        = service.WithContext
+	WithIdempotencyKey = service.WithIdempotencyKey
+	WithMetadata       = service.WithMetadata
+	WithTimeout        = service.WithTimeout
+	WithRetryPolicy    = service.WithRetryPolicy
+	WithHeader         = service.WithHeader
+	WithCacheBypass    = service.WithCacheBypass
+)
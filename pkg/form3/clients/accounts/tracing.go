@@ -0,0 +1,29 @@
+package accounts
+
+import (
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	_tracerName = "github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+
+	_spanCreateAccount = "form3.accounts.create"
+	_spanFetchAccount  = "form3.accounts.fetch"
+	_spanDeleteAccount = "form3.accounts.delete"
+	_spanListAccounts  = "form3.accounts.list"
+
+	_attributeAccountID = attribute.Key("form3.account.id")
+)
+
+func accountAttributes(account models.Account) []attribute.KeyValue {
+	if account.Data == nil {
+		return nil
+	}
+
+	return []attribute.KeyValue{
+		_attributeAccountID.String(account.Data.ID),
+		service.AttributeOrganisationID.String(account.Data.OrganisationID),
+	}
+}
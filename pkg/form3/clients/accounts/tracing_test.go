@@ -0,0 +1,78 @@
+package accounts
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func Test_accountClient_tracing(t *testing.T) {
+	// Arrange
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	var traceparent string
+	httpClient := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		traceparent = req.Header.Get("traceparent")
+		return &http.Response{
+			StatusCode: 404,
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	})
+
+	client := NewAccountClient("baseUrl", WithHttpClient(httpClient), WithTracerProvider(tracerProvider))
+
+	// Act
+	_, err := client.FetchAccount("any_id", WithContext(context.Background()))
+
+	// Assert
+	require.ErrorIs(t, err, ErrAccountNotFound)
+	assert.NotEmpty(t, traceparent)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	httpSpan, methodSpan := spans[0], spans[1]
+	assert.Equal(t, "HTTP GET", httpSpan.Name())
+	assert.Equal(t, methodSpan.SpanContext().SpanID(), httpSpan.Parent().SpanID())
+	assert.Equal(t, _spanFetchAccount, methodSpan.Name())
+	assert.Contains(t, methodSpan.Attributes(), _attributeAccountID.String("any_id"))
+	assert.Contains(t, methodSpan.Attributes(), attribute.Int("http.status_code", 404))
+	assert.Contains(t, methodSpan.Attributes(), service.AttributeErrorClass.String("not_found"))
+}
+
+func Test_accountClient_tracingDisabled(t *testing.T) {
+	// Arrange
+	var header http.Header
+	httpClient := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		header = req.Header
+		return &http.Response{
+			StatusCode: 204,
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	})
+
+	client := NewAccountClient("baseUrl", WithHttpClient(httpClient))
+
+	// Act
+	err := client.DeleteAccount("any_id", 0)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, header.Get("traceparent"))
+}
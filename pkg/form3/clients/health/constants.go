@@ -0,0 +1,11 @@
+package health
+
+import "time"
+
+const (
+	_tracerName = "github.com/francorosatti/form3-api-client/pkg/form3/clients/health"
+
+	_endpointHealth = "health"
+
+	_defaultReadyInterval = 500 * time.Millisecond
+)
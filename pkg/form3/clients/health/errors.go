@@ -0,0 +1,19 @@
+package health
+
+import (
+	"errors"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+)
+
+var (
+	ErrUnhealthy = errors.New("api is not healthy")
+	ErrNotReady  = errors.New("api did not become ready")
+)
+
+// _errors maps every error status code to ErrUnhealthy, see Health.
+var _errors = service.Errors{
+	BadRequest: ErrUnhealthy,
+	NotFound:   ErrUnhealthy,
+	Conflict:   ErrUnhealthy,
+}
@@ -0,0 +1,37 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	_statusDown = "down"
+)
+
+type readinessResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// NewReadinessHandler reports the health of the api, to be mounted in a
+// service readiness endpoint like /readyz. It answers 200 when the api is up
+// and 503 otherwise.
+func NewReadinessHandler(client IHealthClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := readinessResponse{Status: _statusDown}
+		statusCode := http.StatusServiceUnavailable
+
+		health, err := client.Health(r.Context())
+		if err == nil {
+			response.Status = health.Status
+			statusCode = http.StatusOK
+		} else {
+			response.Error = err.Error()
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_ = json.NewEncoder(w).Encode(response)
+	})
+}
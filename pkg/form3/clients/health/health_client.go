@@ -0,0 +1,77 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/jsonapi"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+)
+
+type IHealthClient interface {
+	Health(ctx context.Context) (models.Health, error)
+	WaitUntilReady(ctx context.Context, interval time.Duration) error
+}
+
+type healthClient struct {
+	service  service.Service
+	endpoint endpoints.IEndpoint
+}
+
+func NewHealthClient(baseUrl string, opts ...Option) IHealthClient {
+	s := service.New(_tracerName, _errors, opts)
+
+	return healthClient{
+		service:  s,
+		endpoint: s.Endpoint(_endpointHealth, fmt.Sprintf("%s/health", baseUrl), http.MethodGet),
+	}
+}
+
+// Health returns the status reported by the api. It fails with ErrUnhealthy
+// when the api answers with an error status code or a status other than up.
+func (client healthClient) Health(ctx context.Context) (models.Health, error) {
+	health, err := jsonapi.Call[models.Health](ctx, client.service.Executor(service.NewCallOptions(nil)), client.endpoint)
+	if errors.Is(err, service.ErrResponseStatusCode) {
+		return models.Health{}, endpoints.WrapError(ErrUnhealthy, err)
+	}
+
+	if err != nil {
+		return models.Health{}, err
+	}
+
+	if !health.IsUp() {
+		return health, fmt.Errorf("%w: status %q", ErrUnhealthy, health.Status)
+	}
+
+	return health, nil
+}
+
+// WaitUntilReady polls Health every interval until the api is up or ctx is
+// done, in which case it returns ErrNotReady with the last health error. A
+// non-positive interval polls every 500ms.
+func (client healthClient) WaitUntilReady(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		interval = _defaultReadyInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := client.Health(ctx)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return endpoints.WrapError(ErrNotReady, err)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_healthClient_Health(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		body           string
+		expectedHealth models.Health
+		expectedErr    error
+	}{
+		{
+			name: "given an api that is up" +
+				"when checking health" +
+				"then return up status",
+			statusCode:     http.StatusOK,
+			body:           `{"status":"up"}`,
+			expectedHealth: models.Health{Status: models.HealthStatusUp},
+		},
+		{
+			name: "given an api that reports down" +
+				"when checking health" +
+				"then return status and unhealthy error",
+			statusCode:     http.StatusOK,
+			body:           `{"status":"down"}`,
+			expectedHealth: models.Health{Status: "down"},
+			expectedErr:    ErrUnhealthy,
+		},
+		{
+			name: "given an api answering with an error status code" +
+				"when checking health" +
+				"then return unhealthy error",
+			statusCode:  http.StatusServiceUnavailable,
+			expectedErr: ErrUnhealthy,
+		},
+		{
+			name: "given an invalid body" +
+				"when checking health" +
+				"then return unmarshal error",
+			statusCode:  http.StatusOK,
+			body:        `up`,
+			expectedErr: service.ErrResponseUnmarshal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v1/health", r.URL.Path)
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewHealthClient(server.URL + "/v1")
+
+			// Act
+			health, err := client.Health(context.Background())
+
+			// Assert
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedHealth, health)
+		})
+	}
+}
+
+func Test_healthClient_WaitUntilReady(t *testing.T) {
+	// Arrange
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"up"}`))
+	}))
+	defer server.Close()
+
+	client := NewHealthClient(server.URL + "/v1")
+
+	// Act
+	err := client.WaitUntilReady(context.Background(), time.Millisecond)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func Test_healthClient_WaitUntilReady_zeroInterval(t *testing.T) {
+	// Arrange
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"up"}`))
+	}))
+	defer server.Close()
+
+	client := NewHealthClient(server.URL + "/v1")
+
+	// Act
+	err := client.WaitUntilReady(context.Background(), 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func Test_healthClient_WaitUntilReady_timeout(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewHealthClient(server.URL + "/v1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// Act
+	err := client.WaitUntilReady(ctx, time.Millisecond)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNotReady)
+}
+
+type healthClientFunc func(ctx context.Context) (models.Health, error)
+
+func (f healthClientFunc) Health(ctx context.Context) (models.Health, error) {
+	return f(ctx)
+}
+
+func (f healthClientFunc) WaitUntilReady(context.Context, time.Duration) error {
+	return nil
+}
+
+func TestNewReadinessHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		health             models.Health
+		err                error
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name: "given an api that is up" +
+				"when probing readiness" +
+				"then return ok",
+			health:             models.Health{Status: models.HealthStatusUp},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"status":"up"}` + "\n",
+		},
+		{
+			name: "given an api that is not healthy" +
+				"when probing readiness" +
+				"then return service unavailable with the error",
+			err:                errors.New("connection refused"),
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedBody:       `{"status":"down","error":"connection refused"}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			handler := NewReadinessHandler(healthClientFunc(func(context.Context) (models.Health, error) {
+				return tt.health, tt.err
+			}))
+			recorder := httptest.NewRecorder()
+
+			// Act
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			// Assert
+			assert.Equal(t, tt.expectedStatusCode, recorder.Code)
+			assert.Equal(t, tt.expectedBody, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package health
+
+import "github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+
+type Option = service.Option
+
+var (
+	WithHttpClient     = service.WithHttpClient
+	WithTracerProvider = service.WithTracerProvider
+	WithPropagator     = service.WithPropagator
+	WithMetrics        = service.WithMetrics
+	WithLogger         = service.WithLogger
+)
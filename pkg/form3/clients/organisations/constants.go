@@ -0,0 +1,12 @@
+package organisations
+
+const (
+	_endpointCreateOrganisation = "create_organisation"
+	_endpointFetchOrganisation  = "fetch_organisation"
+	_endpointListOrganisations  = "list_organisations"
+	_endpointUpdateOrganisation = "update_organisation"
+
+	_paramID         = "id"
+	_queryPageNumber = "page[number]"
+	_queryPageSize   = "page[size]"
+)
@@ -0,0 +1,21 @@
+package organisations
+
+import (
+	"errors"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+)
+
+var (
+	ErrOrganisationBadRequest        = errors.New("organisation bad request")
+	ErrOrganisationNotFound          = errors.New("organisation not found")
+	ErrOrganisationConflict          = errors.New("organisation conflict")
+	ErrOrganisationInvalidParameters = errors.New("invalid input parameters")
+)
+
+var _errors = service.Errors{
+	InvalidParameters: ErrOrganisationInvalidParameters,
+	BadRequest:        ErrOrganisationBadRequest,
+	NotFound:          ErrOrganisationNotFound,
+	Conflict:          ErrOrganisationConflict,
+}
@@ -0,0 +1,123 @@
+package organisations
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/jsonapi"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+)
+
+type IOrganisationClient interface {
+	CreateOrganisation(organisation models.Organisation, opts ...CallOption) (models.Organisation, error)
+	FetchOrganisation(organisationID string, opts ...CallOption) (models.Organisation, error)
+	ListOrganisations(pageNumber int, pageSize int, opts ...CallOption) (models.OrganisationList, error)
+	UpdateOrganisation(organisation models.Organisation, opts ...CallOption) (models.Organisation, error)
+}
+
+type organisationClient struct {
+	service   service.Service
+	endpoints map[string]endpoints.IEndpoint
+}
+
+func NewOrganisationClient(baseUrl string, opts ...Option) IOrganisationClient {
+	s := service.New(_tracerName, _errors, opts)
+
+	return organisationClient{
+		service: s,
+		endpoints: map[string]endpoints.IEndpoint{
+			_endpointCreateOrganisation: s.Endpoint(
+				_endpointCreateOrganisation,
+				fmt.Sprintf("%s/organisation/units", baseUrl),
+				http.MethodPost,
+			),
+			_endpointFetchOrganisation: s.Endpoint(
+				_endpointFetchOrganisation,
+				fmt.Sprintf("%s/organisation/units/{id}", baseUrl),
+				http.MethodGet,
+			),
+			_endpointListOrganisations: s.Endpoint(
+				_endpointListOrganisations,
+				fmt.Sprintf("%s/organisation/units", baseUrl),
+				http.MethodGet,
+			),
+			_endpointUpdateOrganisation: s.Endpoint(
+				_endpointUpdateOrganisation,
+				fmt.Sprintf("%s/organisation/units/{id}", baseUrl),
+				http.MethodPatch,
+			),
+		},
+	}
+}
+
+func (client organisationClient) CreateOrganisation(organisation models.Organisation, opts ...CallOption) (_ models.Organisation, err error) {
+	options := service.NewCallOptions(opts)
+
+	ctx, span := client.service.Start(options.Context(), _spanCreateOrganisation, organisationAttributes(organisation.Data)...)
+	defer func() { client.service.End(span, _endpointCreateOrganisation, err) }()
+
+	if organisation.Data == nil {
+		return models.Organisation{}, ErrOrganisationInvalidParameters
+	}
+
+	body, err := client.service.Executor(options).Encode(organisation)
+	if err != nil {
+		return models.Organisation{}, err
+	}
+
+	return jsonapi.Call[models.Organisation](ctx, client.service.Executor(options), client.endpoints[_endpointCreateOrganisation], options.RequestOptions(endpoints.WithBody(body))...)
+}
+
+func (client organisationClient) FetchOrganisation(organisationID string, opts ...CallOption) (_ models.Organisation, err error) {
+	options := service.NewCallOptions(opts)
+
+	ctx, span := client.service.Start(options.Context(), _spanFetchOrganisation, service.AttributeOrganisationID.String(organisationID))
+	defer func() { client.service.End(span, _endpointFetchOrganisation, err) }()
+
+	if organisationID == "" {
+		return models.Organisation{}, ErrOrganisationInvalidParameters
+	}
+
+	return jsonapi.Call[models.Organisation](ctx, client.service.Executor(options), client.endpoints[_endpointFetchOrganisation], options.RequestOptions(endpoints.WithParam(_paramID, organisationID))...)
+}
+
+func (client organisationClient) ListOrganisations(pageNumber int, pageSize int, opts ...CallOption) (_ models.OrganisationList, err error) {
+	options := service.NewCallOptions(opts)
+
+	ctx, span := client.service.Start(options.Context(), _spanListOrganisations)
+	defer func() { client.service.End(span, _endpointListOrganisations, err) }()
+
+	if pageNumber < 0 || pageSize <= 0 {
+		return models.OrganisationList{}, ErrOrganisationInvalidParameters
+	}
+
+	return jsonapi.Call[models.OrganisationList](ctx, client.service.Executor(options), client.endpoints[_endpointListOrganisations], options.RequestOptions(
+		endpoints.WithQueryParam(_queryPageNumber, fmt.Sprintf("%d", pageNumber)),
+		endpoints.WithQueryParam(_queryPageSize, fmt.Sprintf("%d", pageSize)),
+	)...)
+}
+
+// UpdateOrganisation patches the attributes of an organisation. The data
+// must carry the current version, otherwise the api answers with a conflict.
+func (client organisationClient) UpdateOrganisation(organisation models.Organisation, opts ...CallOption) (_ models.Organisation, err error) {
+	options := service.NewCallOptions(opts)
+
+	ctx, span := client.service.Start(options.Context(), _spanUpdateOrganisation, organisationAttributes(organisation.Data)...)
+	defer func() { client.service.End(span, _endpointUpdateOrganisation, err) }()
+
+	if organisation.Data == nil || organisation.Data.ID == "" {
+		return models.Organisation{}, ErrOrganisationInvalidParameters
+	}
+
+	body, err := client.service.Executor(options).Encode(organisation)
+	if err != nil {
+		return models.Organisation{}, err
+	}
+
+	return jsonapi.Call[models.Organisation](ctx, client.service.Executor(options), client.endpoints[_endpointUpdateOrganisation], options.RequestOptions(
+		endpoints.WithParam(_paramID, organisation.Data.ID),
+		endpoints.WithBody(body),
+	)...)
+}
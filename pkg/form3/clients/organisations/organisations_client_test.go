@@ -0,0 +1,125 @@
+package organisations
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type endpointMock struct {
+	mock.Mock
+}
+
+func (m *endpointMock) Do(opts ...endpoints.RequestOption) (*http.Response, error) {
+	called := m.Called(opts)
+	return called.Get(0).(*http.Response), called.Error(1)
+}
+
+func Test_organisationClient_requests(t *testing.T) {
+	// Arrange
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/organisation/units" {
+			_, _ = w.Write([]byte(`{"data":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"id":"organisation_id","attributes":{"name":"name"}}}`))
+	}))
+	defer server.Close()
+
+	client := NewOrganisationClient(server.URL + "/v1")
+	organisation := *new(models.Organisation).WithData(*new(models.OrganisationData).
+		WithID("organisation_id").
+		WithVersion(0).
+		WithAttributes(*new(models.OrganisationAttributes).WithName("name")))
+
+	// Act
+	_, createErr := client.CreateOrganisation(organisation)
+	fetched, fetchErr := client.FetchOrganisation("organisation_id")
+	_, listErr := client.ListOrganisations(1, 20)
+	_, updateErr := client.UpdateOrganisation(organisation)
+
+	// Assert
+	require.NoError(t, createErr)
+	require.NoError(t, fetchErr)
+	require.NoError(t, listErr)
+	require.NoError(t, updateErr)
+	assert.Equal(t, "name", fetched.Data.Attributes.Name)
+	assert.Equal(t, []string{
+		"POST /v1/organisation/units",
+		"GET /v1/organisation/units/organisation_id",
+		"GET /v1/organisation/units?page%5Bnumber%5D=1&page%5Bsize%5D=20",
+		"PATCH /v1/organisation/units/organisation_id",
+	}, requests)
+}
+
+func Test_organisationClient_UpdateOrganisation(t *testing.T) {
+	tests := []struct {
+		name         string
+		organisation models.Organisation
+		statusCode   int
+		expectedErr  error
+	}{
+		{
+			name: "given an organisation without id" +
+				"when updating it" +
+				"then return invalid parameters error",
+			organisation: *new(models.Organisation).WithData(models.OrganisationData{}),
+			expectedErr:  ErrOrganisationInvalidParameters,
+		},
+		{
+			name: "given an organisation" +
+				"when endpoint responds status not found" +
+				"then return not found error",
+			organisation: *new(models.Organisation).WithData(*new(models.OrganisationData).WithID("id")),
+			statusCode:   404,
+			expectedErr:  ErrOrganisationNotFound,
+		},
+		{
+			name: "given an organisation with an old version" +
+				"when endpoint responds status conflict" +
+				"then return conflict error",
+			organisation: *new(models.Organisation).WithData(*new(models.OrganisationData).WithID("id").WithVersion(0)),
+			statusCode:   409,
+			expectedErr:  ErrOrganisationConflict,
+		},
+		{
+			name: "given an organisation" +
+				"when endpoint responds invalid json" +
+				"then return unmarshal error",
+			organisation: *new(models.Organisation).WithData(*new(models.OrganisationData).WithID("id")),
+			statusCode:   200,
+			expectedErr:  service.ErrResponseUnmarshal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			endpoint := &endpointMock{}
+			endpoint.On("Do", mock.Anything).Return(&http.Response{
+				StatusCode: tt.statusCode,
+				Body:       io.NopCloser(strings.NewReader("}")),
+			}, nil)
+
+			client := organisationClient{service: service.New(_tracerName, _errors, nil), endpoints: map[string]endpoints.IEndpoint{_endpointUpdateOrganisation: endpoint}}
+
+			// Act
+			_, err := client.UpdateOrganisation(tt.organisation)
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package organisations
+
+import (
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	_tracerName = "github.com/francorosatti/form3-api-client/pkg/form3/clients/organisations"
+
+	_spanCreateOrganisation = "form3.organisations.create"
+	_spanFetchOrganisation  = "form3.organisations.fetch"
+	_spanListOrganisations  = "form3.organisations.list"
+	_spanUpdateOrganisation = "form3.organisations.update"
+)
+
+func organisationAttributes(data *models.OrganisationData) []attribute.KeyValue {
+	if data == nil {
+		return nil
+	}
+
+	return []attribute.KeyValue{service.AttributeOrganisationID.String(data.ID)}
+}
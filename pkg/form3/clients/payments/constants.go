@@ -0,0 +1,12 @@
+package payments
+
+const (
+	_endpointCreatePayment = "create_payment"
+	_endpointFetchPayment  = "fetch_payment"
+	_endpointListPayments  = "list_payments"
+	_endpointSubmitPayment = "submit_payment"
+
+	_paramID         = "id"
+	_queryPageNumber = "page[number]"
+	_queryPageSize   = "page[size]"
+)
@@ -0,0 +1,21 @@
+package payments
+
+import (
+	"errors"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+)
+
+var (
+	ErrPaymentBadRequest        = errors.New("payment bad request")
+	ErrPaymentNotFound          = errors.New("payment not found")
+	ErrPaymentConflict          = errors.New("payment conflict")
+	ErrPaymentInvalidParameters = errors.New("invalid input parameters")
+)
+
+var _errors = service.Errors{
+	InvalidParameters: ErrPaymentInvalidParameters,
+	BadRequest:        ErrPaymentBadRequest,
+	NotFound:          ErrPaymentNotFound,
+	Conflict:          ErrPaymentConflict,
+}
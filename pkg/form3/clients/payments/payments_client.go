@@ -0,0 +1,129 @@
+package payments
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/jsonapi"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+)
+
+type IPaymentClient interface {
+	CreatePayment(payment models.Payment, opts ...CallOption) (models.Payment, error)
+	FetchPayment(paymentID string, opts ...CallOption) (models.Payment, error)
+	ListPayments(pageNumber int, pageSize int, opts ...CallOption) (models.PaymentList, error)
+	SubmitPayment(paymentID string, submission models.PaymentSubmission, opts ...CallOption) (models.PaymentSubmission, error)
+}
+
+type paymentClient struct {
+	service   service.Service
+	endpoints map[string]endpoints.IEndpoint
+}
+
+func NewPaymentClient(baseUrl string, opts ...Option) IPaymentClient {
+	s := service.New(_tracerName, _errors, opts)
+
+	return paymentClient{
+		service: s,
+		endpoints: map[string]endpoints.IEndpoint{
+			_endpointCreatePayment: s.Endpoint(
+				_endpointCreatePayment,
+				fmt.Sprintf("%s/transaction/payments", baseUrl),
+				http.MethodPost,
+			),
+			_endpointFetchPayment: s.Endpoint(
+				_endpointFetchPayment,
+				fmt.Sprintf("%s/transaction/payments/{id}", baseUrl),
+				http.MethodGet,
+			),
+			_endpointListPayments: s.Endpoint(
+				_endpointListPayments,
+				fmt.Sprintf("%s/transaction/payments", baseUrl),
+				http.MethodGet,
+			),
+			_endpointSubmitPayment: s.Endpoint(
+				_endpointSubmitPayment,
+				fmt.Sprintf("%s/transaction/payments/{id}/submissions", baseUrl),
+				http.MethodPost,
+			),
+		},
+	}
+}
+
+func (client paymentClient) CreatePayment(payment models.Payment, opts ...CallOption) (_ models.Payment, err error) {
+	options := service.NewCallOptions(opts)
+
+	ctx, span := client.service.Start(options.Context(), _spanCreatePayment, paymentAttributes(payment.Data)...)
+	defer func() { client.service.End(span, _endpointCreatePayment, err) }()
+
+	if payment.Data == nil {
+		return models.Payment{}, ErrPaymentInvalidParameters
+	}
+
+	body, err := client.service.Executor(options).Encode(payment)
+	if err != nil {
+		return models.Payment{}, err
+	}
+
+	return jsonapi.Call[models.Payment](ctx, client.service.Executor(options), client.endpoints[_endpointCreatePayment], options.RequestOptions(endpoints.WithBody(body))...)
+}
+
+func (client paymentClient) FetchPayment(paymentID string, opts ...CallOption) (_ models.Payment, err error) {
+	options := service.NewCallOptions(opts)
+
+	ctx, span := client.service.Start(options.Context(), _spanFetchPayment, _attributePaymentID.String(paymentID))
+	defer func() { client.service.End(span, _endpointFetchPayment, err) }()
+
+	if paymentID == "" {
+		return models.Payment{}, ErrPaymentInvalidParameters
+	}
+
+	payment, err := jsonapi.Call[models.Payment](ctx, client.service.Executor(options), client.endpoints[_endpointFetchPayment], options.RequestOptions(endpoints.WithParam(_paramID, paymentID))...)
+	if err == nil {
+		span.SetAttributes(paymentAttributes(payment.Data)...)
+	}
+
+	return payment, err
+}
+
+func (client paymentClient) ListPayments(pageNumber int, pageSize int, opts ...CallOption) (_ models.PaymentList, err error) {
+	options := service.NewCallOptions(opts)
+
+	ctx, span := client.service.Start(options.Context(), _spanListPayments)
+	defer func() { client.service.End(span, _endpointListPayments, err) }()
+
+	if pageNumber < 0 || pageSize <= 0 {
+		return models.PaymentList{}, ErrPaymentInvalidParameters
+	}
+
+	return jsonapi.Call[models.PaymentList](ctx, client.service.Executor(options), client.endpoints[_endpointListPayments], options.RequestOptions(
+		endpoints.WithQueryParam(_queryPageNumber, fmt.Sprintf("%d", pageNumber)),
+		endpoints.WithQueryParam(_queryPageSize, fmt.Sprintf("%d", pageSize)),
+	)...)
+}
+
+// SubmitPayment sends a created payment to the payment scheme. The
+// submission only needs an id and an organisation id, its status is set by
+// the api.
+func (client paymentClient) SubmitPayment(paymentID string, submission models.PaymentSubmission, opts ...CallOption) (_ models.PaymentSubmission, err error) {
+	options := service.NewCallOptions(opts)
+
+	ctx, span := client.service.Start(options.Context(), _spanSubmitPayment, _attributePaymentID.String(paymentID))
+	defer func() { client.service.End(span, _endpointSubmitPayment, err) }()
+
+	if paymentID == "" || submission.Data == nil {
+		return models.PaymentSubmission{}, ErrPaymentInvalidParameters
+	}
+
+	body, err := client.service.Executor(options).Encode(submission)
+	if err != nil {
+		return models.PaymentSubmission{}, err
+	}
+
+	return jsonapi.Call[models.PaymentSubmission](ctx, client.service.Executor(options), client.endpoints[_endpointSubmitPayment], options.RequestOptions(
+		endpoints.WithParam(_paramID, paymentID),
+		endpoints.WithBody(body),
+	)...)
+}
@@ -0,0 +1,242 @@
+package payments
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type endpointMock struct {
+	mock.Mock
+}
+
+func (m *endpointMock) Do(opts ...endpoints.RequestOption) (*http.Response, error) {
+	called := m.Called(opts)
+	return called.Get(0).(*http.Response), called.Error(1)
+}
+
+func TestNewPaymentClient(t *testing.T) {
+	// Act
+	client := NewPaymentClient("baseUrl").(paymentClient)
+
+	// Assert
+	for _, name := range []string{_endpointCreatePayment, _endpointFetchPayment, _endpointListPayments, _endpointSubmitPayment} {
+		_, exists := client.endpoints[name]
+		assert.True(t, exists, name)
+	}
+}
+
+func Test_paymentClient_requests(t *testing.T) {
+	// Arrange
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		_, _ = w.Write([]byte(`{"data":{"id":"payment_id"}}`))
+	}))
+	defer server.Close()
+
+	client := NewPaymentClient(server.URL + "/v1")
+	payment := *new(models.Payment).WithData(*new(models.PaymentData).WithID("payment_id"))
+	submission := *new(models.PaymentSubmission).WithData(*new(models.PaymentSubmissionData).WithID("submission_id"))
+
+	// Act
+	_, createErr := client.CreatePayment(payment)
+	fetched, fetchErr := client.FetchPayment("payment_id")
+	_, submitErr := client.SubmitPayment("payment_id", submission)
+
+	// Assert
+	require.NoError(t, createErr)
+	require.NoError(t, fetchErr)
+	require.NoError(t, submitErr)
+	assert.Equal(t, "payment_id", fetched.Data.ID)
+	assert.Equal(t, []string{
+		"POST /v1/transaction/payments",
+		"GET /v1/transaction/payments/payment_id",
+		"POST /v1/transaction/payments/payment_id/submissions",
+	}, requests)
+}
+
+func Test_paymentClient_ListPayments(t *testing.T) {
+	tests := []struct {
+		name        string
+		pageNumber  int
+		pageSize    int
+		statusCode  int
+		body        string
+		expectedOut models.PaymentList
+		expectedErr error
+	}{
+		{
+			name: "given a negative page number" +
+				"when listing payments" +
+				"then return invalid parameters error",
+			pageNumber:  -1,
+			pageSize:    10,
+			expectedErr: ErrPaymentInvalidParameters,
+		},
+		{
+			name: "given valid pages" +
+				"when endpoint responds status ok" +
+				"then return payments",
+			pageNumber: 0,
+			pageSize:   10,
+			statusCode: 200,
+			body: `{"data":[{"id":"id","attributes":{"amount":"10.50","currency":"GBP",` +
+				`"beneficiary_party":{"account_number":"12345678"}}}],"links":{"next":"next"}}`,
+			expectedOut: models.PaymentList{
+				Data: []models.PaymentData{*new(models.PaymentData).WithID("id").WithAttributes(*new(models.PaymentAttributes).
+					WithAmount("10.50").
+					WithCurrency("GBP").
+					WithBeneficiaryParty(*new(models.PaymentParty).WithAccountNumber("12345678", "")))},
+				Links: &models.Links{Next: "next"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			endpoint := &endpointMock{}
+			endpoint.On("Do", mock.Anything).Return(&http.Response{
+				StatusCode: tt.statusCode,
+				Body:       io.NopCloser(strings.NewReader(tt.body)),
+			}, nil)
+
+			client := paymentClient{service: service.New(_tracerName, _errors, nil), endpoints: map[string]endpoints.IEndpoint{_endpointListPayments: endpoint}}
+
+			// Act
+			got, err := client.ListPayments(tt.pageNumber, tt.pageSize)
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+			assert.Equal(t, tt.expectedOut, got)
+			assert.Equal(t, tt.expectedOut.Links != nil && tt.expectedOut.Links.Next != "", got.HasNext())
+		})
+	}
+}
+
+func Test_paymentClient_SubmitPayment(t *testing.T) {
+	tests := []struct {
+		name        string
+		paymentID   string
+		submission  models.PaymentSubmission
+		statusCode  int
+		expectedErr error
+	}{
+		{
+			name: "given an empty payment id" +
+				"when submitting payment" +
+				"then return invalid parameters error",
+			submission:  *new(models.PaymentSubmission).WithData(models.PaymentSubmissionData{}),
+			expectedErr: ErrPaymentInvalidParameters,
+		},
+		{
+			name: "given a submission without data" +
+				"when submitting payment" +
+				"then return invalid parameters error",
+			paymentID:   "id",
+			expectedErr: ErrPaymentInvalidParameters,
+		},
+		{
+			name: "given a payment" +
+				"when endpoint responds status not found" +
+				"then return not found error",
+			paymentID:   "id",
+			submission:  *new(models.PaymentSubmission).WithData(models.PaymentSubmissionData{}),
+			statusCode:  404,
+			expectedErr: ErrPaymentNotFound,
+		},
+		{
+			name: "given a payment" +
+				"when endpoint responds status conflict" +
+				"then return conflict error",
+			paymentID:   "id",
+			submission:  *new(models.PaymentSubmission).WithData(models.PaymentSubmissionData{}),
+			statusCode:  409,
+			expectedErr: ErrPaymentConflict,
+		},
+		{
+			name: "given a payment" +
+				"when endpoint responds status bad request" +
+				"then return bad request error",
+			paymentID:   "id",
+			submission:  *new(models.PaymentSubmission).WithData(models.PaymentSubmissionData{}),
+			statusCode:  400,
+			expectedErr: ErrPaymentBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			endpoint := &endpointMock{}
+			endpoint.On("Do", mock.Anything).Return(&http.Response{
+				StatusCode: tt.statusCode,
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil)
+
+			client := paymentClient{service: service.New(_tracerName, _errors, nil), endpoints: map[string]endpoints.IEndpoint{_endpointSubmitPayment: endpoint}}
+
+			// Act
+			_, err := client.SubmitPayment(tt.paymentID, tt.submission)
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
+
+func Test_paymentClient_CreatePayment_body(t *testing.T) {
+	// Arrange
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	debtor := *new(models.PaymentParty).WithAccountNumber("12345678", "BBAN").WithBank("400300", "GBDSC")
+	payment := *new(models.Payment).WithData(*new(models.PaymentData).
+		WithID("id").
+		WithType("payments").
+		WithAttributes(*new(models.PaymentAttributes).
+			WithAmount("10.50").
+			WithCurrency("GBP").
+			WithDebtorParty(debtor).
+			WithPaymentScheme("FPS").
+			WithReference("invoice 1")))
+
+	// Act
+	created, err := NewPaymentClient(server.URL).CreatePayment(payment)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, payment, created)
+
+	var document map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &document))
+	assert.Equal(t, map[string]interface{}{
+		"amount":         "10.50",
+		"currency":       "GBP",
+		"payment_scheme": "FPS",
+		"reference":      "invoice 1",
+		"debtor_party": map[string]interface{}{
+			"account_number":      "12345678",
+			"account_number_code": "BBAN",
+			"bank_id":             "400300",
+			"bank_id_code":        "GBDSC",
+		},
+	}, document["data"].(map[string]interface{})["attributes"])
+}
@@ -0,0 +1,29 @@
+package payments
+
+import (
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	_tracerName = "github.com/francorosatti/form3-api-client/pkg/form3/clients/payments"
+
+	_spanCreatePayment = "form3.payments.create"
+	_spanFetchPayment  = "form3.payments.fetch"
+	_spanListPayments  = "form3.payments.list"
+	_spanSubmitPayment = "form3.payments.submit"
+
+	_attributePaymentID = attribute.Key("form3.payment.id")
+)
+
+func paymentAttributes(data *models.PaymentData) []attribute.KeyValue {
+	if data == nil {
+		return nil
+	}
+
+	return []attribute.KeyValue{
+		_attributePaymentID.String(data.ID),
+		service.AttributeOrganisationID.String(data.OrganisationID),
+	}
+}
@@ -0,0 +1,12 @@
+package subscriptions
+
+const (
+	_endpointCreateSubscription = "create_subscription"
+	_endpointListSubscriptions  = "list_subscriptions"
+	_endpointDeleteSubscription = "delete_subscription"
+
+	_paramID         = "id"
+	_queryVersion    = "version"
+	_queryPageNumber = "page[number]"
+	_queryPageSize   = "page[size]"
+)
@@ -0,0 +1,21 @@
+package subscriptions
+
+import (
+	"errors"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+)
+
+var (
+	ErrSubscriptionBadRequest        = errors.New("subscription bad request")
+	ErrSubscriptionNotFound          = errors.New("subscription not found")
+	ErrSubscriptionConflict          = errors.New("subscription conflict")
+	ErrSubscriptionInvalidParameters = errors.New("invalid input parameters")
+)
+
+var _errors = service.Errors{
+	InvalidParameters: ErrSubscriptionInvalidParameters,
+	BadRequest:        ErrSubscriptionBadRequest,
+	NotFound:          ErrSubscriptionNotFound,
+	Conflict:          ErrSubscriptionConflict,
+}
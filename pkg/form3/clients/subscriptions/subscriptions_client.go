@@ -0,0 +1,99 @@
+package subscriptions
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/jsonapi"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+)
+
+type ISubscriptionClient interface {
+	CreateSubscription(subscription models.Subscription, opts ...CallOption) (models.Subscription, error)
+	ListSubscriptions(pageNumber int, pageSize int, opts ...CallOption) (models.SubscriptionList, error)
+	DeleteSubscription(subscriptionID string, version int64, opts ...CallOption) error
+}
+
+type subscriptionClient struct {
+	service   service.Service
+	endpoints map[string]endpoints.IEndpoint
+}
+
+func NewSubscriptionClient(baseUrl string, opts ...Option) ISubscriptionClient {
+	s := service.New(_tracerName, _errors, opts)
+
+	return subscriptionClient{
+		service: s,
+		endpoints: map[string]endpoints.IEndpoint{
+			_endpointCreateSubscription: s.Endpoint(
+				_endpointCreateSubscription,
+				fmt.Sprintf("%s/notification/subscriptions", baseUrl),
+				http.MethodPost,
+			),
+			_endpointListSubscriptions: s.Endpoint(
+				_endpointListSubscriptions,
+				fmt.Sprintf("%s/notification/subscriptions", baseUrl),
+				http.MethodGet,
+			),
+			_endpointDeleteSubscription: s.Endpoint(
+				_endpointDeleteSubscription,
+				fmt.Sprintf("%s/notification/subscriptions/{id}", baseUrl),
+				http.MethodDelete,
+			),
+		},
+	}
+}
+
+func (client subscriptionClient) CreateSubscription(subscription models.Subscription, opts ...CallOption) (_ models.Subscription, err error) {
+	options := service.NewCallOptions(opts)
+
+	ctx, span := client.service.Start(options.Context(), _spanCreateSubscription, subscriptionAttributes(subscription.Data)...)
+	defer func() { client.service.End(span, _endpointCreateSubscription, err) }()
+
+	if subscription.Data == nil {
+		return models.Subscription{}, ErrSubscriptionInvalidParameters
+	}
+
+	body, err := client.service.Executor(options).Encode(subscription)
+	if err != nil {
+		return models.Subscription{}, err
+	}
+
+	return jsonapi.Call[models.Subscription](ctx, client.service.Executor(options), client.endpoints[_endpointCreateSubscription], options.RequestOptions(endpoints.WithBody(body))...)
+}
+
+func (client subscriptionClient) ListSubscriptions(pageNumber int, pageSize int, opts ...CallOption) (_ models.SubscriptionList, err error) {
+	options := service.NewCallOptions(opts)
+
+	ctx, span := client.service.Start(options.Context(), _spanListSubscriptions)
+	defer func() { client.service.End(span, _endpointListSubscriptions, err) }()
+
+	if pageNumber < 0 || pageSize <= 0 {
+		return models.SubscriptionList{}, ErrSubscriptionInvalidParameters
+	}
+
+	return jsonapi.Call[models.SubscriptionList](ctx, client.service.Executor(options), client.endpoints[_endpointListSubscriptions], options.RequestOptions(
+		endpoints.WithQueryParam(_queryPageNumber, fmt.Sprintf("%d", pageNumber)),
+		endpoints.WithQueryParam(_queryPageSize, fmt.Sprintf("%d", pageSize)),
+	)...)
+}
+
+func (client subscriptionClient) DeleteSubscription(subscriptionID string, version int64, opts ...CallOption) (err error) {
+	options := service.NewCallOptions(opts)
+
+	ctx, span := client.service.Start(options.Context(), _spanDeleteSubscription, _attributeSubscriptionID.String(subscriptionID))
+	defer func() { client.service.End(span, _endpointDeleteSubscription, err) }()
+
+	if subscriptionID == "" {
+		return ErrSubscriptionInvalidParameters
+	}
+
+	err = client.service.Executor(options).Do(ctx, client.endpoints[_endpointDeleteSubscription], options.RequestOptions(
+		endpoints.WithParam(_paramID, subscriptionID),
+		endpoints.WithQueryParam(_queryVersion, fmt.Sprintf("%d", version)),
+	)...)
+
+	return err
+}
@@ -0,0 +1,178 @@
+package subscriptions
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type endpointMock struct {
+	mock.Mock
+}
+
+func (m *endpointMock) Do(opts ...endpoints.RequestOption) (*http.Response, error) {
+	called := m.Called(opts)
+	return called.Get(0).(*http.Response), called.Error(1)
+}
+
+func Test_subscriptionClient_requests(t *testing.T) {
+	// Arrange
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		_, _ = w.Write([]byte(`{"data":{"id":"subscription_id"}}`))
+	}))
+	defer server.Close()
+
+	client := NewSubscriptionClient(server.URL + "/v1")
+	subscription := *new(models.Subscription).WithData(*new(models.SubscriptionData).
+		WithID("subscription_id").
+		WithAttributes(*new(models.SubscriptionAttributes).
+			WithCallbackURI("https://example.com/webhooks").
+			WithEventType(models.EventTypeUpdated).
+			WithRecordType(models.RecordTypePayments)))
+
+	// Act
+	created, createErr := client.CreateSubscription(subscription)
+	deleteErr := client.DeleteSubscription("subscription_id", 2)
+
+	// Assert
+	require.NoError(t, createErr)
+	require.NoError(t, deleteErr)
+	assert.Equal(t, "subscription_id", created.Data.ID)
+	assert.Equal(t, []string{
+		"POST /v1/notification/subscriptions",
+		"DELETE /v1/notification/subscriptions/subscription_id?version=2",
+	}, requests)
+}
+
+func Test_subscriptionClient_ListSubscriptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		pageNumber  int
+		pageSize    int
+		statusCode  int
+		body        string
+		expectedOut models.SubscriptionList
+		expectedErr error
+	}{
+		{
+			name: "given a zero page size" +
+				"when listing subscriptions" +
+				"then return invalid parameters error",
+			pageSize:    0,
+			expectedErr: ErrSubscriptionInvalidParameters,
+		},
+		{
+			name: "given valid pages" +
+				"when endpoint responds status ok" +
+				"then return subscriptions",
+			pageSize:   10,
+			statusCode: 200,
+			body:       `{"data":[{"id":"id","attributes":{"callback_uri":"uri","event_type":"created","record_type":"accounts"}}]}`,
+			expectedOut: models.SubscriptionList{
+				Data: []models.SubscriptionData{*new(models.SubscriptionData).WithID("id").WithAttributes(models.SubscriptionAttributes{
+					CallbackURI: "uri",
+					EventType:   models.EventTypeCreated,
+					RecordType:  models.RecordTypeAccounts,
+				})},
+			},
+		},
+		{
+			name: "given valid pages" +
+				"when endpoint responds status internal server error" +
+				"then return status code error",
+			pageSize:    10,
+			statusCode:  500,
+			expectedErr: service.ErrResponseStatusCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			endpoint := &endpointMock{}
+			endpoint.On("Do", mock.Anything).Return(&http.Response{
+				StatusCode: tt.statusCode,
+				Body:       io.NopCloser(strings.NewReader(tt.body)),
+			}, nil)
+
+			client := subscriptionClient{service: service.New(_tracerName, _errors, nil), endpoints: map[string]endpoints.IEndpoint{_endpointListSubscriptions: endpoint}}
+
+			// Act
+			got, err := client.ListSubscriptions(tt.pageNumber, tt.pageSize)
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+			assert.Equal(t, tt.expectedOut, got)
+		})
+	}
+}
+
+func Test_subscriptionClient_DeleteSubscription(t *testing.T) {
+	tests := []struct {
+		name           string
+		subscriptionID string
+		statusCode     int
+		expectedErr    error
+	}{
+		{
+			name: "given an empty subscription id" +
+				"when deleting subscription" +
+				"then return invalid parameters error",
+			expectedErr: ErrSubscriptionInvalidParameters,
+		},
+		{
+			name: "given a subscription id" +
+				"when endpoint responds status no content" +
+				"then return no error",
+			subscriptionID: "id",
+			statusCode:     204,
+		},
+		{
+			name: "given a subscription id" +
+				"when endpoint responds status not found" +
+				"then return not found error",
+			subscriptionID: "id",
+			statusCode:     404,
+			expectedErr:    ErrSubscriptionNotFound,
+		},
+		{
+			name: "given a subscription id" +
+				"when endpoint responds status conflict" +
+				"then return conflict error",
+			subscriptionID: "id",
+			statusCode:     409,
+			expectedErr:    ErrSubscriptionConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			endpoint := &endpointMock{}
+			endpoint.On("Do", mock.Anything).Return(&http.Response{
+				StatusCode: tt.statusCode,
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil)
+
+			client := subscriptionClient{service: service.New(_tracerName, _errors, nil), endpoints: map[string]endpoints.IEndpoint{_endpointDeleteSubscription: endpoint}}
+
+			// Act
+			err := client.DeleteSubscription(tt.subscriptionID, 0)
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+		})
+	}
+}
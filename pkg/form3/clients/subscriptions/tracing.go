@@ -0,0 +1,28 @@
+package subscriptions
+
+import (
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	_tracerName = "github.com/francorosatti/form3-api-client/pkg/form3/clients/subscriptions"
+
+	_spanCreateSubscription = "form3.subscriptions.create"
+	_spanListSubscriptions  = "form3.subscriptions.list"
+	_spanDeleteSubscription = "form3.subscriptions.delete"
+
+	_attributeSubscriptionID = attribute.Key("form3.subscription.id")
+)
+
+func subscriptionAttributes(data *models.SubscriptionData) []attribute.KeyValue {
+	if data == nil {
+		return nil
+	}
+
+	return []attribute.KeyValue{
+		_attributeSubscriptionID.String(data.ID),
+		service.AttributeOrganisationID.String(data.OrganisationID),
+	}
+}
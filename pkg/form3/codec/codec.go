@@ -0,0 +1,33 @@
+package codec
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// ICodec encodes request documents and decodes response documents. It can
+// be implemented with another json library for performance.
+type ICodec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Decode(r io.Reader, v interface{}) error
+}
+
+// JSON is the encoding/json codec. Unknown fields are ignored unless
+// DisallowUnknownFields is set, which is meant for tests and contract checks
+// that should fail when the api and the models drift apart.
+type JSON struct {
+	DisallowUnknownFields bool
+}
+
+func (c JSON) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (c JSON) Decode(r io.Reader, v interface{}) error {
+	decoder := json.NewDecoder(r)
+	if c.DisallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+
+	return decoder.Decode(v)
+}
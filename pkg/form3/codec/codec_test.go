@@ -0,0 +1,61 @@
+package codec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSON_Decode(t *testing.T) {
+	type document struct {
+		ID string `json:"id"`
+	}
+
+	tests := []struct {
+		name        string
+		codec       JSON
+		body        string
+		expectedOut document
+		expectedErr bool
+	}{
+		{
+			name: "given a lenient codec" +
+				"when decoding unknown fields" +
+				"then ignore them",
+			body:        `{"id":"id","unknown":true}`,
+			expectedOut: document{ID: "id"},
+		},
+		{
+			name: "given a strict codec" +
+				"when decoding unknown fields" +
+				"then return error",
+			codec:       JSON{DisallowUnknownFields: true},
+			body:        `{"id":"id","unknown":true}`,
+			expectedOut: document{ID: "id"},
+			expectedErr: true,
+		},
+		{
+			name: "given a strict codec" +
+				"when decoding known fields" +
+				"then return the document",
+			codec:       JSON{DisallowUnknownFields: true},
+			body:        `{"id":"id"}`,
+			expectedOut: document{ID: "id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			got := document{}
+
+			// Act
+			err := tt.codec.Decode(strings.NewReader(tt.body), &got)
+
+			// Assert
+			assert.Equal(t, tt.expectedErr, err != nil)
+			assert.Equal(t, tt.expectedOut, got)
+		})
+	}
+}
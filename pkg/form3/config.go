@@ -0,0 +1,288 @@
+package form3
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	EnvConfigFile          = "FORM3_CONFIG_FILE"
+	EnvEnvironment         = "FORM3_ENVIRONMENT"
+	EnvBaseURL             = "FORM3_BASE_URL"
+	EnvTimeout             = "FORM3_TIMEOUT"
+	EnvRetryMaxAttempts    = "FORM3_RETRY_MAX_ATTEMPTS"
+	EnvRetryInitialBackoff = "FORM3_RETRY_INITIAL_BACKOFF"
+	EnvRetryMaxBackoff     = "FORM3_RETRY_MAX_BACKOFF"
+	EnvRateLimit           = "FORM3_RATE_LIMIT"
+	EnvRateLimitBurst      = "FORM3_RATE_LIMIT_BURST"
+	EnvAuthToken           = "FORM3_AUTH_TOKEN"
+	EnvTLSCertFile         = "FORM3_TLS_CERT_FILE"
+	EnvTLSKeyFile          = "FORM3_TLS_KEY_FILE"
+	EnvTLSCAFile           = "FORM3_TLS_CA_FILE"
+	EnvTLSMinVersion       = "FORM3_TLS_MIN_VERSION"
+	EnvOrganisationID      = "FORM3_ORGANISATION_ID"
+
+	EnvAllowDestructiveOperations = "FORM3_ALLOW_DESTRUCTIVE_OPERATIONS"
+)
+
+var (
+	_tlsVersions = map[string]uint16{
+		"1.2": tls.VersionTLS12,
+		"1.3": tls.VersionTLS13,
+	}
+)
+
+type (
+	// Config holds every client setting that can be changed per deployment.
+	// BaseURL replaces the environment host, like WithHost.
+	Config struct {
+		Environment    Environment   `yaml:"environment"`
+		BaseURL        string        `yaml:"base_url"`
+		Timeout        time.Duration `yaml:"timeout"`
+		Retry          RetryPolicy   `yaml:"retry"`
+		RateLimit      RateLimit     `yaml:"rate_limit"`
+		Auth           AuthConfig    `yaml:"auth"`
+		TLS            TLSConfig     `yaml:"tls"`
+		OrganisationID string        `yaml:"organisation_id"`
+
+		AllowDestructiveOperations bool `yaml:"allow_destructive_operations"`
+	}
+
+	AuthConfig struct {
+		Token string `yaml:"token"`
+	}
+
+	TLSConfig struct {
+		CertFile          string `yaml:"cert_file"`
+		KeyFile           string `yaml:"key_file"`
+		CAFile            string `yaml:"ca_file"`
+		MinVersion        string `yaml:"min_version"`
+		ReloadCertificate bool   `yaml:"reload_certificate"`
+	}
+)
+
+// NewClientFromConfig creates a client configured by LoadConfig. Options
+// given by the caller are applied after the configuration.
+func NewClientFromConfig(path string, opts ...Option) (IClient, error) {
+	config, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(config.Environment, append(config.options(), opts...)...)
+}
+
+// LoadConfig reads the configuration from a yaml or json file, when path or
+// FORM3_CONFIG_FILE is set, and then overrides it with the FORM3_*
+// environment variables. The result is validated.
+func LoadConfig(path string) (Config, error) {
+	config := Config{Timeout: _defaultTimeout}
+
+	if path == "" {
+		path = os.Getenv(EnvConfigFile)
+	}
+
+	if path != "" {
+		if err := config.readFile(path); err != nil {
+			return Config{}, err
+		}
+	}
+
+	if err := config.readEnv(); err != nil {
+		return Config{}, err
+	}
+
+	if err := config.Validate(); err != nil {
+		return Config{}, err
+	}
+
+	return config, nil
+}
+
+// Validate returns an ErrInvalidConfig error listing every invalid setting.
+func (c Config) Validate() error {
+	var problems []string
+
+	if c.Environment == "" {
+		problems = append(problems, "environment is required")
+	} else if _, exists := LookupEnvironment(c.Environment); !exists {
+		problems = append(problems, fmt.Sprintf("unknown environment %q", c.Environment))
+	}
+
+	if c.BaseURL != "" {
+		if u, err := url.Parse(c.BaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			problems = append(problems, fmt.Sprintf("base_url %q must be an absolute http or https url", c.BaseURL))
+		}
+	}
+
+	if c.Timeout < 0 {
+		problems = append(problems, "timeout must not be negative")
+	}
+
+	if c.Retry.MaxAttempts < 0 {
+		problems = append(problems, "retry.max_attempts must not be negative")
+	}
+
+	if c.Retry.InitialBackoff < 0 || c.Retry.MaxBackoff < 0 {
+		problems = append(problems, "retry backoffs must not be negative")
+	}
+
+	if c.Retry.MaxBackoff > 0 && c.Retry.MaxBackoff < c.Retry.InitialBackoff {
+		problems = append(problems, "retry.max_backoff must not be lower than retry.initial_backoff")
+	}
+
+	if c.RateLimit.RequestsPerSecond < 0 || c.RateLimit.Burst < 0 {
+		problems = append(problems, "rate_limit must not be negative")
+	}
+
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		problems = append(problems, "tls.cert_file and tls.key_file must be set together")
+	}
+
+	if _, exists := _tlsVersions[c.TLS.MinVersion]; c.TLS.MinVersion != "" && !exists {
+		problems = append(problems, fmt.Sprintf("unknown tls.min_version %q, use 1.2 or 1.3", c.TLS.MinVersion))
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidConfig, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func (c *Config) readFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return endpoints.WrapError(ErrLoadConfig, err)
+	}
+
+	// json documents are valid yaml, so a single decoder reads both formats
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return endpoints.WrapError(ErrLoadConfig, fmt.Errorf("%s: %w", path, err))
+	}
+
+	return nil
+}
+
+func (c *Config) readEnv() error {
+	var problems []string
+
+	lookup := func(name string, parse func(string) error) {
+		value, exists := os.LookupEnv(name)
+		if !exists || value == "" {
+			return
+		}
+
+		if err := parse(value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s", name, err))
+		}
+	}
+
+	lookupString := func(name string, target *string) {
+		lookup(name, func(value string) error {
+			*target = value
+			return nil
+		})
+	}
+
+	lookupDuration := func(name string, target *time.Duration) {
+		lookup(name, func(value string) (err error) {
+			*target, err = time.ParseDuration(value)
+			return err
+		})
+	}
+
+	lookupInt := func(name string, target *int) {
+		lookup(name, func(value string) (err error) {
+			*target, err = strconv.Atoi(value)
+			return err
+		})
+	}
+
+	lookup(EnvEnvironment, func(value string) error {
+		c.Environment = Environment(value)
+		return nil
+	})
+	lookupString(EnvBaseURL, &c.BaseURL)
+	lookupDuration(EnvTimeout, &c.Timeout)
+	lookupInt(EnvRetryMaxAttempts, &c.Retry.MaxAttempts)
+	lookupDuration(EnvRetryInitialBackoff, &c.Retry.InitialBackoff)
+	lookupDuration(EnvRetryMaxBackoff, &c.Retry.MaxBackoff)
+	lookup(EnvRateLimit, func(value string) (err error) {
+		c.RateLimit.RequestsPerSecond, err = strconv.ParseFloat(value, 64)
+		return err
+	})
+	lookupInt(EnvRateLimitBurst, &c.RateLimit.Burst)
+	lookupString(EnvAuthToken, &c.Auth.Token)
+	lookupString(EnvTLSCertFile, &c.TLS.CertFile)
+	lookupString(EnvTLSKeyFile, &c.TLS.KeyFile)
+	lookupString(EnvTLSCAFile, &c.TLS.CAFile)
+	lookupString(EnvTLSMinVersion, &c.TLS.MinVersion)
+	lookupString(EnvOrganisationID, &c.OrganisationID)
+	lookup(EnvAllowDestructiveOperations, func(value string) (err error) {
+		c.AllowDestructiveOperations, err = strconv.ParseBool(value)
+		return err
+	})
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidConfig, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func (c Config) options() []Option {
+	opts := []Option{
+		WithTimeout(c.Timeout),
+		WithRetryPolicy(c.Retry),
+		WithRateLimit(c.RateLimit),
+	}
+
+	if c.BaseURL != "" {
+		opts = append(opts, WithHost(strings.TrimSuffix(c.BaseURL, "/")))
+	}
+
+	if c.Auth.Token != "" {
+		opts = append(opts, WithBearerToken(c.Auth.Token))
+	}
+
+	if c.TLS.CertFile != "" {
+		opts = append(opts, WithClientCertificate(c.TLS.CertFile, c.TLS.KeyFile))
+	}
+
+	if c.TLS.CAFile != "" {
+		opts = append(opts, WithRootCAsFile(c.TLS.CAFile))
+	}
+
+	if version, exists := _tlsVersions[c.TLS.MinVersion]; exists {
+		opts = append(opts, WithMinTLSVersion(version))
+	}
+
+	if c.TLS.ReloadCertificate {
+		opts = append(opts, WithCertificateReload())
+	}
+
+	if c.OrganisationID != "" {
+		opts = append(opts, WithOrganisationID(c.OrganisationID))
+	}
+
+	if c.AllowDestructiveOperations {
+		opts = append(opts, WithDestructiveOperations())
+	}
+
+	return opts
+}
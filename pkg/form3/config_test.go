@@ -0,0 +1,191 @@
+package form3
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	yamlFile := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(yamlFile, []byte(`
+environment: test
+base_url: https://api.example.com/
+timeout: 5s
+retry:
+  max_attempts: 3
+  initial_backoff: 200ms
+rate_limit:
+  requests_per_second: 10
+  burst: 5
+tls:
+  min_version: "1.3"
+organisation_id: file-organisation
+`), 0600))
+
+	jsonFile := filepath.Join(dir, "config.json")
+	require.NoError(t, os.WriteFile(jsonFile, []byte(`{"environment":"production","auth":{"token":"secret"}}`), 0600))
+
+	unknownFieldFile := filepath.Join(dir, "unknown.yaml")
+	require.NoError(t, os.WriteFile(unknownFieldFile, []byte("environment: test\nretries: 3\n"), 0600))
+
+	tests := []struct {
+		name           string
+		path           string
+		env            map[string]string
+		expectedConfig Config
+		expectedErr    error
+	}{
+		{
+			name: "given a yaml file" +
+				"when loading config" +
+				"then return the file settings",
+			path: yamlFile,
+			expectedConfig: Config{
+				Environment: EnvironmentTest,
+				BaseURL:     "https://api.example.com/",
+				Timeout:     5 * time.Second,
+				Retry:       RetryPolicy{MaxAttempts: 3, InitialBackoff: 200 * time.Millisecond},
+				RateLimit:   RateLimit{RequestsPerSecond: 10, Burst: 5},
+				TLS:         TLSConfig{MinVersion: "1.3"},
+
+				OrganisationID: "file-organisation",
+			},
+		},
+		{
+			name: "given a json file set by environment variable" +
+				"when loading config" +
+				"then return the file settings with the default timeout",
+			env: map[string]string{EnvConfigFile: jsonFile},
+			expectedConfig: Config{
+				Environment: EnvironmentProduction,
+				Timeout:     _defaultTimeout,
+				Auth:        AuthConfig{Token: "secret"},
+			},
+		},
+		{
+			name: "given a yaml file and environment variables" +
+				"when loading config" +
+				"then environment variables override the file",
+			path: yamlFile,
+			env: map[string]string{
+				EnvEnvironment:      string(EnvironmentLocal),
+				EnvTimeout:          "1s",
+				EnvRetryMaxAttempts: "5",
+				EnvTLSCertFile:      "cert.pem",
+				EnvTLSKeyFile:       "key.pem",
+				EnvOrganisationID:   "env-organisation",
+			},
+			expectedConfig: Config{
+				Environment: EnvironmentLocal,
+				BaseURL:     "https://api.example.com/",
+				Timeout:     time.Second,
+				Retry:       RetryPolicy{MaxAttempts: 5, InitialBackoff: 200 * time.Millisecond},
+				RateLimit:   RateLimit{RequestsPerSecond: 10, Burst: 5},
+				TLS:         TLSConfig{CertFile: "cert.pem", KeyFile: "key.pem", MinVersion: "1.3"},
+
+				OrganisationID: "env-organisation",
+			},
+		},
+		{
+			name: "given a missing file" +
+				"when loading config" +
+				"then return load config error",
+			path:        filepath.Join(dir, "missing.yaml"),
+			expectedErr: ErrLoadConfig,
+		},
+		{
+			name: "given a file with an unknown field" +
+				"when loading config" +
+				"then return load config error",
+			path:        unknownFieldFile,
+			expectedErr: ErrLoadConfig,
+		},
+		{
+			name: "given an invalid environment variable" +
+				"when loading config" +
+				"then return invalid config error",
+			env:         map[string]string{EnvEnvironment: string(EnvironmentTest), EnvTimeout: "soon"},
+			expectedErr: ErrInvalidConfig,
+		},
+		{
+			name: "given no environment" +
+				"when loading config" +
+				"then return invalid config error",
+			expectedErr: ErrInvalidConfig,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			t.Setenv(EnvConfigFile, "")
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			// Act
+			config, err := LoadConfig(tt.path)
+
+			// Assert
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedConfig, config)
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	// Arrange
+	config := Config{
+		Environment: "staging",
+		BaseURL:     "api.example.com",
+		Timeout:     -time.Second,
+		Retry:       RetryPolicy{InitialBackoff: time.Second, MaxBackoff: time.Millisecond},
+		TLS:         TLSConfig{CertFile: "cert.pem", MinVersion: "1.0"},
+	}
+
+	// Act
+	err := config.Validate()
+
+	// Assert
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+	assert.EqualError(t, err, `invalid config: unknown environment "staging"; `+
+		`base_url "api.example.com" must be an absolute http or https url; `+
+		`timeout must not be negative; `+
+		`retry.max_backoff must not be lower than retry.initial_backoff; `+
+		`tls.cert_file and tls.key_file must be set together; `+
+		`unknown tls.min_version "1.0", use 1.2 or 1.3`)
+}
+
+func TestNewClientFromConfig(t *testing.T) {
+	// Arrange
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	t.Setenv(EnvConfigFile, "")
+	t.Setenv(EnvEnvironment, string(EnvironmentLocal))
+	t.Setenv(EnvBaseURL, server.URL)
+	t.Setenv(EnvAuthToken, "secret")
+
+	client, err := NewClientFromConfig("")
+	require.NoError(t, err)
+
+	// Act
+	err = client.DeleteAccount("ad27e265-9605-4b4b-a0e5-3003ea9cc4dc", 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer secret", authorization)
+}
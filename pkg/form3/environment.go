@@ -1,19 +1,76 @@
 package form3
 
+import (
+	"fmt"
+	"net/url"
+	"sync"
+)
+
 type Environment string
 
 const (
-	EnvironmentLocal      = "local"
-	EnvironmentTest       = "test"
-	EnvironmentProduction = "production"
+	EnvironmentLocal      Environment = "local"
+	EnvironmentTest       Environment = "test"
+	EnvironmentProduction Environment = "production"
 
 	_apiVersion = "v1"
 )
 
+type (
+	// EnvironmentConfig describes where an environment is served. Destructive
+	// operations against production environments are blocked unless the
+	// client is created with WithDestructiveOperations.
+	EnvironmentConfig struct {
+		Host       string
+		APIVersion string
+		Production bool
+	}
+)
+
 var (
-	_hostByEnvironment = map[Environment]string{
-		EnvironmentLocal:      "http://localhost:8080",
-		EnvironmentTest:       "https://internal.form3.com/test",
-		EnvironmentProduction: "https://internal.form3.com",
+	_environmentsMu sync.RWMutex
+	_environments   = map[Environment]EnvironmentConfig{
+		EnvironmentLocal:      {Host: "http://localhost:8080", APIVersion: _apiVersion},
+		EnvironmentTest:       {Host: "https://internal.form3.com/test", APIVersion: _apiVersion},
+		EnvironmentProduction: {Host: "https://internal.form3.com", APIVersion: _apiVersion, Production: true},
 	}
 )
+
+// RegisterEnvironment makes a custom environment available to NewClient.
+// The api version defaults to v1. Registered environments, including the
+// built-in ones, cannot be replaced.
+func RegisterEnvironment(env Environment, config EnvironmentConfig) error {
+	if env == "" {
+		return fmt.Errorf("%w: empty environment name", ErrInvalidEnvironment)
+	}
+
+	if u, err := url.Parse(config.Host); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: host %q must be an absolute http or https url", ErrInvalidEnvironment, config.Host)
+	}
+
+	if config.APIVersion == "" {
+		config.APIVersion = _apiVersion
+	}
+
+	_environmentsMu.Lock()
+	defer _environmentsMu.Unlock()
+
+	if _, exists := _environments[env]; exists {
+		return fmt.Errorf("%w: %s", ErrEnvironmentAlreadyRegistered, env)
+	}
+
+	_environments[env] = config
+
+	return nil
+}
+
+// LookupEnvironment returns the configuration of a built-in or registered
+// environment.
+func LookupEnvironment(env Environment) (EnvironmentConfig, bool) {
+	_environmentsMu.RLock()
+	defer _environmentsMu.RUnlock()
+
+	config, exists := _environments[env]
+
+	return config, exists
+}
@@ -0,0 +1,162 @@
+package form3
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterEnvironment(t *testing.T) {
+	tests := []struct {
+		name        string
+		env         Environment
+		config      EnvironmentConfig
+		expectedErr error
+	}{
+		{
+			name: "given a new environment" +
+				"when registering it" +
+				"then return no error",
+			env:         "register-test-staging",
+			config:      EnvironmentConfig{Host: "https://staging.example.com"},
+			expectedErr: nil,
+		},
+		{
+			name: "given a built-in environment" +
+				"when registering it" +
+				"then return already registered error",
+			env:         EnvironmentProduction,
+			config:      EnvironmentConfig{Host: "https://example.com"},
+			expectedErr: ErrEnvironmentAlreadyRegistered,
+		},
+		{
+			name: "given a relative host" +
+				"when registering an environment" +
+				"then return invalid environment error",
+			env:         "register-test-relative",
+			config:      EnvironmentConfig{Host: "example.com"},
+			expectedErr: ErrInvalidEnvironment,
+		},
+		{
+			name: "given an empty name" +
+				"when registering an environment" +
+				"then return invalid environment error",
+			env:         "",
+			config:      EnvironmentConfig{Host: "https://example.com"},
+			expectedErr: ErrInvalidEnvironment,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := RegisterEnvironment(tt.env, tt.config)
+			if err == nil {
+				unregisterEnvironment(t, tt.env)
+			}
+
+			// Assert
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestNewClient_registeredEnvironment(t *testing.T) {
+	// Arrange
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	require.NoError(t, RegisterEnvironment("client-test-sandbox", EnvironmentConfig{Host: server.URL, APIVersion: "v2"}))
+	unregisterEnvironment(t, "client-test-sandbox")
+
+	client, err := NewClient("client-test-sandbox")
+	require.NoError(t, err)
+
+	// Act
+	err = client.DeleteAccount("ad27e265-9605-4b4b-a0e5-3003ea9cc4dc", 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "/v2/organisation/accounts/ad27e265-9605-4b4b-a0e5-3003ea9cc4dc", path)
+}
+
+func TestNewClient_destructiveOperations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	require.NoError(t, RegisterEnvironment("client-test-live", EnvironmentConfig{Host: server.URL, Production: true}))
+	unregisterEnvironment(t, "client-test-live")
+
+	tests := []struct {
+		name        string
+		env         Environment
+		opts        []Option
+		expectedErr error
+	}{
+		{
+			name: "given the production environment" +
+				"when deleting an account" +
+				"then return destructive operation blocked error",
+			env:         EnvironmentProduction,
+			opts:        []Option{WithHost(server.URL)},
+			expectedErr: ErrDestructiveOperationBlocked,
+		},
+		{
+			name: "given a registered production environment" +
+				"when deleting an account" +
+				"then return destructive operation blocked error",
+			env:         "client-test-live",
+			expectedErr: ErrDestructiveOperationBlocked,
+		},
+		{
+			name: "given the production environment and the destructive operations opt-in" +
+				"when deleting an account" +
+				"then return no error",
+			env:         EnvironmentProduction,
+			opts:        []Option{WithHost(server.URL), WithDestructiveOperations()},
+			expectedErr: nil,
+		},
+		{
+			name: "given the test environment" +
+				"when deleting an account" +
+				"then return no error",
+			env:         EnvironmentTest,
+			opts:        []Option{WithHost(server.URL)},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			client, err := NewClient(tt.env, tt.opts...)
+			require.NoError(t, err)
+
+			// Act
+			err = client.DeleteAccount("ad27e265-9605-4b4b-a0e5-3003ea9cc4dc", 0)
+
+			// Assert
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+// unregisterEnvironment removes an environment registered by a test when
+// the test ends, so that tests can run more than once in the same process.
+func unregisterEnvironment(t *testing.T, env Environment) {
+	t.Cleanup(func() {
+		_environmentsMu.Lock()
+		defer _environmentsMu.Unlock()
+
+		delete(_environments, env)
+	})
+}
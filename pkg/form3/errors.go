@@ -3,5 +3,12 @@ package form3
 import "errors"
 
 var (
-	ErrUnknownEnvironment = errors.New("unknown environment")
-)
\ No newline at end of file
+	ErrUnknownEnvironment           = errors.New("unknown environment")
+	ErrInvalidEnvironment           = errors.New("invalid environment")
+	ErrEnvironmentAlreadyRegistered = errors.New("environment already registered")
+	ErrDestructiveOperationBlocked  = errors.New("destructive operation blocked in production environment")
+	ErrLoadClientCertificate        = errors.New("error loading client certificate")
+	ErrLoadRootCAs                  = errors.New("error loading root certificate authorities")
+	ErrLoadConfig                   = errors.New("error loading config")
+	ErrInvalidConfig                = errors.New("invalid config")
+)
@@ -0,0 +1,420 @@
+package form3mock
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/organisations"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/payments"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/subscriptions"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	MethodCreateAccount  = "CreateAccount"
+	MethodFetchAccount   = "FetchAccount"
+	MethodDeleteAccount  = "DeleteAccount"
+	MethodListAccounts   = "ListAccounts"
+	MethodHealth         = "Health"
+	MethodWaitUntilReady = "WaitUntilReady"
+	MethodCreatePayment  = "CreatePayment"
+	MethodFetchPayment   = "FetchPayment"
+	MethodListPayments   = "ListPayments"
+	MethodSubmitPayment  = "SubmitPayment"
+
+	MethodCreateOrganisation = "CreateOrganisation"
+	MethodFetchOrganisation  = "FetchOrganisation"
+	MethodListOrganisations  = "ListOrganisations"
+	MethodUpdateOrganisation = "UpdateOrganisation"
+
+	MethodCreateSubscription = "CreateSubscription"
+	MethodListSubscriptions  = "ListSubscriptions"
+	MethodDeleteSubscription = "DeleteSubscription"
+)
+
+var (
+	_ form3.IClient = (*Client)(nil)
+)
+
+type (
+	// Client is a configurable stub of form3.IClient that records every call.
+	// Methods without a configured func return ErrUnexpectedCall.
+	Client struct {
+		CreateAccountFunc  func(account models.Account, opts ...accounts.CallOption) (models.Account, error)
+		FetchAccountFunc   func(accountID string, opts ...accounts.CallOption) (models.Account, error)
+		DeleteAccountFunc  func(accountID string, version int64, opts ...accounts.CallOption) error
+		ListAccountsFunc   func(pageNumber int, pageSize int, opts ...accounts.CallOption) (models.AccountList, error)
+		HealthFunc         func(ctx context.Context) (models.Health, error)
+		WaitUntilReadyFunc func(ctx context.Context, interval time.Duration) error
+		CreatePaymentFunc  func(payment models.Payment, opts ...payments.CallOption) (models.Payment, error)
+		FetchPaymentFunc   func(paymentID string, opts ...payments.CallOption) (models.Payment, error)
+		ListPaymentsFunc   func(pageNumber int, pageSize int, opts ...payments.CallOption) (models.PaymentList, error)
+		SubmitPaymentFunc  func(paymentID string, submission models.PaymentSubmission, opts ...payments.CallOption) (models.PaymentSubmission, error)
+
+		CreateOrganisationFunc func(organisation models.Organisation, opts ...organisations.CallOption) (models.Organisation, error)
+		FetchOrganisationFunc  func(organisationID string, opts ...organisations.CallOption) (models.Organisation, error)
+		ListOrganisationsFunc  func(pageNumber int, pageSize int, opts ...organisations.CallOption) (models.OrganisationList, error)
+		UpdateOrganisationFunc func(organisation models.Organisation, opts ...organisations.CallOption) (models.Organisation, error)
+
+		CreateSubscriptionFunc func(subscription models.Subscription, opts ...subscriptions.CallOption) (models.Subscription, error)
+		ListSubscriptionsFunc  func(pageNumber int, pageSize int, opts ...subscriptions.CallOption) (models.SubscriptionList, error)
+		DeleteSubscriptionFunc func(subscriptionID string, version int64, opts ...subscriptions.CallOption) error
+
+		mu    sync.Mutex
+		calls []Call
+	}
+
+	// Call holds the arguments of a call, without its call options.
+	Call struct {
+		Method string
+		Args   []interface{}
+	}
+)
+
+func (c *Client) CreateAccount(account models.Account, opts ...accounts.CallOption) (models.Account, error) {
+	c.record(MethodCreateAccount, account)
+
+	if c.CreateAccountFunc == nil {
+		return models.Account{}, unexpectedCall(MethodCreateAccount)
+	}
+
+	return c.CreateAccountFunc(account, opts...)
+}
+
+func (c *Client) FetchAccount(accountID string, opts ...accounts.CallOption) (models.Account, error) {
+	c.record(MethodFetchAccount, accountID)
+
+	if c.FetchAccountFunc == nil {
+		return models.Account{}, unexpectedCall(MethodFetchAccount)
+	}
+
+	return c.FetchAccountFunc(accountID, opts...)
+}
+
+func (c *Client) DeleteAccount(accountID string, version int64, opts ...accounts.CallOption) error {
+	c.record(MethodDeleteAccount, accountID, version)
+
+	if c.DeleteAccountFunc == nil {
+		return unexpectedCall(MethodDeleteAccount)
+	}
+
+	return c.DeleteAccountFunc(accountID, version, opts...)
+}
+
+func (c *Client) ListAccounts(pageNumber int, pageSize int, opts ...accounts.CallOption) (models.AccountList, error) {
+	c.record(MethodListAccounts, pageNumber, pageSize)
+
+	if c.ListAccountsFunc == nil {
+		return models.AccountList{}, unexpectedCall(MethodListAccounts)
+	}
+
+	return c.ListAccountsFunc(pageNumber, pageSize, opts...)
+}
+
+func (c *Client) Health(ctx context.Context) (models.Health, error) {
+	c.record(MethodHealth)
+
+	if c.HealthFunc == nil {
+		return models.Health{}, unexpectedCall(MethodHealth)
+	}
+
+	return c.HealthFunc(ctx)
+}
+
+func (c *Client) WaitUntilReady(ctx context.Context, interval time.Duration) error {
+	c.record(MethodWaitUntilReady, interval)
+
+	if c.WaitUntilReadyFunc == nil {
+		return unexpectedCall(MethodWaitUntilReady)
+	}
+
+	return c.WaitUntilReadyFunc(ctx, interval)
+}
+
+func (c *Client) CreatePayment(payment models.Payment, opts ...payments.CallOption) (models.Payment, error) {
+	c.record(MethodCreatePayment, payment)
+
+	if c.CreatePaymentFunc == nil {
+		return models.Payment{}, unexpectedCall(MethodCreatePayment)
+	}
+
+	return c.CreatePaymentFunc(payment, opts...)
+}
+
+func (c *Client) FetchPayment(paymentID string, opts ...payments.CallOption) (models.Payment, error) {
+	c.record(MethodFetchPayment, paymentID)
+
+	if c.FetchPaymentFunc == nil {
+		return models.Payment{}, unexpectedCall(MethodFetchPayment)
+	}
+
+	return c.FetchPaymentFunc(paymentID, opts...)
+}
+
+func (c *Client) ListPayments(pageNumber int, pageSize int, opts ...payments.CallOption) (models.PaymentList, error) {
+	c.record(MethodListPayments, pageNumber, pageSize)
+
+	if c.ListPaymentsFunc == nil {
+		return models.PaymentList{}, unexpectedCall(MethodListPayments)
+	}
+
+	return c.ListPaymentsFunc(pageNumber, pageSize, opts...)
+}
+
+func (c *Client) SubmitPayment(paymentID string, submission models.PaymentSubmission, opts ...payments.CallOption) (models.PaymentSubmission, error) {
+	c.record(MethodSubmitPayment, paymentID, submission)
+
+	if c.SubmitPaymentFunc == nil {
+		return models.PaymentSubmission{}, unexpectedCall(MethodSubmitPayment)
+	}
+
+	return c.SubmitPaymentFunc(paymentID, submission, opts...)
+}
+
+func (c *Client) CreateOrganisation(organisation models.Organisation, opts ...organisations.CallOption) (models.Organisation, error) {
+	c.record(MethodCreateOrganisation, organisation)
+
+	if c.CreateOrganisationFunc == nil {
+		return models.Organisation{}, unexpectedCall(MethodCreateOrganisation)
+	}
+
+	return c.CreateOrganisationFunc(organisation, opts...)
+}
+
+func (c *Client) FetchOrganisation(organisationID string, opts ...organisations.CallOption) (models.Organisation, error) {
+	c.record(MethodFetchOrganisation, organisationID)
+
+	if c.FetchOrganisationFunc == nil {
+		return models.Organisation{}, unexpectedCall(MethodFetchOrganisation)
+	}
+
+	return c.FetchOrganisationFunc(organisationID, opts...)
+}
+
+func (c *Client) ListOrganisations(pageNumber int, pageSize int, opts ...organisations.CallOption) (models.OrganisationList, error) {
+	c.record(MethodListOrganisations, pageNumber, pageSize)
+
+	if c.ListOrganisationsFunc == nil {
+		return models.OrganisationList{}, unexpectedCall(MethodListOrganisations)
+	}
+
+	return c.ListOrganisationsFunc(pageNumber, pageSize, opts...)
+}
+
+func (c *Client) UpdateOrganisation(organisation models.Organisation, opts ...organisations.CallOption) (models.Organisation, error) {
+	c.record(MethodUpdateOrganisation, organisation)
+
+	if c.UpdateOrganisationFunc == nil {
+		return models.Organisation{}, unexpectedCall(MethodUpdateOrganisation)
+	}
+
+	return c.UpdateOrganisationFunc(organisation, opts...)
+}
+
+func (c *Client) CreateSubscription(subscription models.Subscription, opts ...subscriptions.CallOption) (models.Subscription, error) {
+	c.record(MethodCreateSubscription, subscription)
+
+	if c.CreateSubscriptionFunc == nil {
+		return models.Subscription{}, unexpectedCall(MethodCreateSubscription)
+	}
+
+	return c.CreateSubscriptionFunc(subscription, opts...)
+}
+
+func (c *Client) ListSubscriptions(pageNumber int, pageSize int, opts ...subscriptions.CallOption) (models.SubscriptionList, error) {
+	c.record(MethodListSubscriptions, pageNumber, pageSize)
+
+	if c.ListSubscriptionsFunc == nil {
+		return models.SubscriptionList{}, unexpectedCall(MethodListSubscriptions)
+	}
+
+	return c.ListSubscriptionsFunc(pageNumber, pageSize, opts...)
+}
+
+func (c *Client) DeleteSubscription(subscriptionID string, version int64, opts ...subscriptions.CallOption) error {
+	c.record(MethodDeleteSubscription, subscriptionID, version)
+
+	if c.DeleteSubscriptionFunc == nil {
+		return unexpectedCall(MethodDeleteSubscription)
+	}
+
+	return c.DeleteSubscriptionFunc(subscriptionID, version, opts...)
+}
+
+// ReturnCreateAccount makes CreateAccount always return the given values.
+func (c *Client) ReturnCreateAccount(account models.Account, err error) *Client {
+	c.CreateAccountFunc = func(models.Account, ...accounts.CallOption) (models.Account, error) {
+		return account, err
+	}
+	return c
+}
+
+// ReturnFetchAccount makes FetchAccount always return the given values.
+func (c *Client) ReturnFetchAccount(account models.Account, err error) *Client {
+	c.FetchAccountFunc = func(string, ...accounts.CallOption) (models.Account, error) {
+		return account, err
+	}
+	return c
+}
+
+// ReturnDeleteAccount makes DeleteAccount always return the given error.
+func (c *Client) ReturnDeleteAccount(err error) *Client {
+	c.DeleteAccountFunc = func(string, int64, ...accounts.CallOption) error {
+		return err
+	}
+	return c
+}
+
+// ReturnListAccounts makes ListAccounts always return the given values.
+func (c *Client) ReturnListAccounts(list models.AccountList, err error) *Client {
+	c.ListAccountsFunc = func(int, int, ...accounts.CallOption) (models.AccountList, error) {
+		return list, err
+	}
+	return c
+}
+
+// ReturnHealth makes Health always return the given values.
+func (c *Client) ReturnHealth(health models.Health, err error) *Client {
+	c.HealthFunc = func(context.Context) (models.Health, error) {
+		return health, err
+	}
+	return c
+}
+
+// ReturnCreatePayment makes CreatePayment always return the given values.
+func (c *Client) ReturnCreatePayment(payment models.Payment, err error) *Client {
+	c.CreatePaymentFunc = func(models.Payment, ...payments.CallOption) (models.Payment, error) {
+		return payment, err
+	}
+	return c
+}
+
+// ReturnFetchPayment makes FetchPayment always return the given values.
+func (c *Client) ReturnFetchPayment(payment models.Payment, err error) *Client {
+	c.FetchPaymentFunc = func(string, ...payments.CallOption) (models.Payment, error) {
+		return payment, err
+	}
+	return c
+}
+
+// ReturnListPayments makes ListPayments always return the given values.
+func (c *Client) ReturnListPayments(list models.PaymentList, err error) *Client {
+	c.ListPaymentsFunc = func(int, int, ...payments.CallOption) (models.PaymentList, error) {
+		return list, err
+	}
+	return c
+}
+
+// ReturnSubmitPayment makes SubmitPayment always return the given values.
+func (c *Client) ReturnSubmitPayment(submission models.PaymentSubmission, err error) *Client {
+	c.SubmitPaymentFunc = func(string, models.PaymentSubmission, ...payments.CallOption) (models.PaymentSubmission, error) {
+		return submission, err
+	}
+	return c
+}
+
+// ReturnCreateOrganisation makes CreateOrganisation always return the given values.
+func (c *Client) ReturnCreateOrganisation(organisation models.Organisation, err error) *Client {
+	c.CreateOrganisationFunc = func(models.Organisation, ...organisations.CallOption) (models.Organisation, error) {
+		return organisation, err
+	}
+	return c
+}
+
+// ReturnFetchOrganisation makes FetchOrganisation always return the given values.
+func (c *Client) ReturnFetchOrganisation(organisation models.Organisation, err error) *Client {
+	c.FetchOrganisationFunc = func(string, ...organisations.CallOption) (models.Organisation, error) {
+		return organisation, err
+	}
+	return c
+}
+
+// ReturnListOrganisations makes ListOrganisations always return the given values.
+func (c *Client) ReturnListOrganisations(list models.OrganisationList, err error) *Client {
+	c.ListOrganisationsFunc = func(int, int, ...organisations.CallOption) (models.OrganisationList, error) {
+		return list, err
+	}
+	return c
+}
+
+// ReturnUpdateOrganisation makes UpdateOrganisation always return the given values.
+func (c *Client) ReturnUpdateOrganisation(organisation models.Organisation, err error) *Client {
+	c.UpdateOrganisationFunc = func(models.Organisation, ...organisations.CallOption) (models.Organisation, error) {
+		return organisation, err
+	}
+	return c
+}
+
+// ReturnCreateSubscription makes CreateSubscription always return the given values.
+func (c *Client) ReturnCreateSubscription(subscription models.Subscription, err error) *Client {
+	c.CreateSubscriptionFunc = func(models.Subscription, ...subscriptions.CallOption) (models.Subscription, error) {
+		return subscription, err
+	}
+	return c
+}
+
+// ReturnListSubscriptions makes ListSubscriptions always return the given values.
+func (c *Client) ReturnListSubscriptions(list models.SubscriptionList, err error) *Client {
+	c.ListSubscriptionsFunc = func(int, int, ...subscriptions.CallOption) (models.SubscriptionList, error) {
+		return list, err
+	}
+	return c
+}
+
+// ReturnDeleteSubscription makes DeleteSubscription always return the given error.
+func (c *Client) ReturnDeleteSubscription(err error) *Client {
+	c.DeleteSubscriptionFunc = func(string, int64, ...subscriptions.CallOption) error {
+		return err
+	}
+	return c
+}
+
+func (c *Client) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Call{}, c.calls...)
+}
+
+func (c *Client) CallsTo(method string) []Call {
+	var calls []Call
+	for _, call := range c.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// AssertCalled asserts that method was called at least once with args.
+func (c *Client) AssertCalled(t testing.TB, method string, args ...interface{}) bool {
+	t.Helper()
+
+	for _, call := range c.CallsTo(method) {
+		if assert.ObjectsAreEqual(args, call.Args) {
+			return true
+		}
+	}
+
+	return assert.Fail(t, "call not found", "%s was not called with %v, calls: %v", method, args, c.CallsTo(method))
+}
+
+// AssertNumberOfCalls asserts that method was called exactly expected times.
+func (c *Client) AssertNumberOfCalls(t testing.TB, method string, expected int) bool {
+	t.Helper()
+
+	return assert.Len(t, c.CallsTo(method), expected, "number of calls to %s", method)
+}
+
+func (c *Client) record(method string, args ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = append(c.calls, Call{Method: method, Args: args})
+}
@@ -0,0 +1,49 @@
+package form3mock
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient(t *testing.T) {
+	// Arrange
+	account := *new(models.Account).WithData(*new(models.AccountData).WithID("id"))
+	client := (&Client{}).
+		ReturnFetchAccount(account, nil).
+		ReturnDeleteAccount(accounts.ErrAccountConflict)
+
+	// Act
+	fetched, fetchErr := client.FetchAccount("id")
+	deleteErr := client.DeleteAccount("id", 1)
+	_, createErr := client.CreateAccount(account)
+
+	// Assert
+	assert.NoError(t, fetchErr)
+	assert.Equal(t, account, fetched)
+	assert.True(t, errors.Is(deleteErr, accounts.ErrAccountConflict))
+	assert.True(t, errors.Is(createErr, ErrUnexpectedCall))
+
+	client.AssertCalled(t, MethodFetchAccount, "id")
+	client.AssertCalled(t, MethodDeleteAccount, "id", int64(1))
+	client.AssertCalled(t, MethodCreateAccount, account)
+	client.AssertNumberOfCalls(t, MethodFetchAccount, 1)
+	assert.Len(t, client.Calls(), 3)
+}
+
+func TestClient_AssertCalled(t *testing.T) {
+	// Arrange
+	client := (&Client{}).ReturnFetchAccount(models.Account{}, nil)
+	_, _ = client.FetchAccount("id")
+	mockT := &testing.T{}
+
+	// Act
+	got := client.AssertCalled(mockT, MethodFetchAccount, "other_id")
+
+	// Assert
+	assert.False(t, got)
+	assert.True(t, mockT.Failed())
+}
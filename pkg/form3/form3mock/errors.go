@@ -0,0 +1,20 @@
+package form3mock
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+)
+
+var (
+	ErrUnexpectedCall = errors.New("unexpected call")
+)
+
+func unexpectedCall(method string) error {
+	return fmt.Errorf("%w: %s", ErrUnexpectedCall, method)
+}
+
+func wrap(sentinel error, err error) error {
+	return endpoints.WrapError(sentinel, err)
+}
@@ -0,0 +1,461 @@
+package form3mock
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/organisations"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/payments"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/subscriptions"
+	"github.com/francorosatti/form3-api-client/pkg/form3/form3test"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+)
+
+var (
+	_ form3.IClient = (*StatefulClient)(nil)
+)
+
+// StatefulClient is an in-memory form3.IClient that behaves like the real
+// api: created accounts can be fetched and deleted with their version, and
+// created payments can be submitted once. Subscriptions are stored but no
+// notification is ever sent.
+type StatefulClient struct {
+	mu       sync.Mutex
+	accounts map[string]models.AccountData
+	order    []string
+
+	payments     map[string]models.PaymentData
+	paymentOrder []string
+	submitted    map[string]bool
+
+	subscriptions     map[string]models.SubscriptionData
+	subscriptionOrder []string
+
+	organisations     map[string]models.OrganisationData
+	organisationOrder []string
+}
+
+func NewStatefulClient() *StatefulClient {
+	return &StatefulClient{
+		accounts:      make(map[string]models.AccountData),
+		payments:      make(map[string]models.PaymentData),
+		submitted:     make(map[string]bool),
+		subscriptions: make(map[string]models.SubscriptionData),
+		organisations: make(map[string]models.OrganisationData),
+	}
+}
+
+func (c *StatefulClient) CreateAccount(account models.Account, _ ...accounts.CallOption) (models.Account, error) {
+	if account.Data == nil {
+		return models.Account{}, accounts.ErrAccountBadRequest
+	}
+
+	if err := form3test.ValidateAccount(*account.Data); err != nil {
+		return models.Account{}, wrap(accounts.ErrAccountBadRequest, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.accounts[account.Data.ID]; exists {
+		return models.Account{}, accounts.ErrAccountConflict
+	}
+
+	data := copyAccountData(*account.Data)
+	data.WithVersion(0)
+	c.accounts[data.ID] = data
+	c.order = append(c.order, data.ID)
+
+	return *new(models.Account).WithData(copyAccountData(data)), nil
+}
+
+func (c *StatefulClient) FetchAccount(accountID string, _ ...accounts.CallOption) (models.Account, error) {
+	if accountID == "" {
+		return models.Account{}, accounts.ErrAccountInvalidParameters
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, exists := c.accounts[accountID]
+	if !exists {
+		return models.Account{}, accounts.ErrAccountNotFound
+	}
+
+	return *new(models.Account).WithData(copyAccountData(data)), nil
+}
+
+func (c *StatefulClient) DeleteAccount(accountID string, version int64, _ ...accounts.CallOption) error {
+	if accountID == "" {
+		return accounts.ErrAccountInvalidParameters
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, exists := c.accounts[accountID]
+	if !exists {
+		return accounts.ErrAccountNotFound
+	}
+
+	if *data.Version != version {
+		return accounts.ErrAccountConflict
+	}
+
+	delete(c.accounts, accountID)
+	for i, id := range c.order {
+		if id == accountID {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (c *StatefulClient) ListAccounts(pageNumber int, pageSize int, _ ...accounts.CallOption) (models.AccountList, error) {
+	if pageNumber < 0 || pageSize <= 0 {
+		return models.AccountList{}, accounts.ErrAccountInvalidParameters
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	list := models.AccountList{
+		Data:  []models.AccountData{},
+		Links: &models.Links{},
+	}
+
+	for i := pageNumber * pageSize; i < len(c.order) && len(list.Data) < pageSize; i++ {
+		list.Data = append(list.Data, copyAccountData(c.accounts[c.order[i]]))
+	}
+
+	if (pageNumber+1)*pageSize < len(c.order) {
+		list.Links.Next = fmt.Sprintf("page[number]=%d&page[size]=%d", pageNumber+1, pageSize)
+	}
+
+	return list, nil
+}
+
+func (c *StatefulClient) CreatePayment(payment models.Payment, _ ...payments.CallOption) (models.Payment, error) {
+	if payment.Data == nil || payment.Data.ID == "" {
+		return models.Payment{}, payments.ErrPaymentBadRequest
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.payments[payment.Data.ID]; exists {
+		return models.Payment{}, payments.ErrPaymentConflict
+	}
+
+	data := copyPaymentData(*payment.Data)
+	data.WithVersion(0)
+	c.payments[data.ID] = data
+	c.paymentOrder = append(c.paymentOrder, data.ID)
+
+	return *new(models.Payment).WithData(copyPaymentData(data)), nil
+}
+
+func (c *StatefulClient) FetchPayment(paymentID string, _ ...payments.CallOption) (models.Payment, error) {
+	if paymentID == "" {
+		return models.Payment{}, payments.ErrPaymentInvalidParameters
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, exists := c.payments[paymentID]
+	if !exists {
+		return models.Payment{}, payments.ErrPaymentNotFound
+	}
+
+	return *new(models.Payment).WithData(copyPaymentData(data)), nil
+}
+
+func (c *StatefulClient) ListPayments(pageNumber int, pageSize int, _ ...payments.CallOption) (models.PaymentList, error) {
+	if pageNumber < 0 || pageSize <= 0 {
+		return models.PaymentList{}, payments.ErrPaymentInvalidParameters
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	list := models.PaymentList{
+		Data:  []models.PaymentData{},
+		Links: &models.Links{},
+	}
+
+	for i := pageNumber * pageSize; i < len(c.paymentOrder) && len(list.Data) < pageSize; i++ {
+		list.Data = append(list.Data, copyPaymentData(c.payments[c.paymentOrder[i]]))
+	}
+
+	if (pageNumber+1)*pageSize < len(c.paymentOrder) {
+		list.Links.Next = fmt.Sprintf("page[number]=%d&page[size]=%d", pageNumber+1, pageSize)
+	}
+
+	return list, nil
+}
+
+// SubmitPayment accepts every payment immediately. A payment can only be
+// submitted once.
+func (c *StatefulClient) SubmitPayment(paymentID string, submission models.PaymentSubmission, _ ...payments.CallOption) (models.PaymentSubmission, error) {
+	if paymentID == "" || submission.Data == nil {
+		return models.PaymentSubmission{}, payments.ErrPaymentInvalidParameters
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.payments[paymentID]; !exists {
+		return models.PaymentSubmission{}, payments.ErrPaymentNotFound
+	}
+
+	if c.submitted[paymentID] {
+		return models.PaymentSubmission{}, payments.ErrPaymentConflict
+	}
+
+	c.submitted[paymentID] = true
+
+	data := *submission.Data
+	data.Attributes = &models.PaymentSubmissionAttributes{Status: "accepted"}
+	data.Version = nil
+
+	return *new(models.PaymentSubmission).WithData(data), nil
+}
+
+func (c *StatefulClient) CreateOrganisation(organisation models.Organisation, _ ...organisations.CallOption) (models.Organisation, error) {
+	if organisation.Data == nil || organisation.Data.ID == "" {
+		return models.Organisation{}, organisations.ErrOrganisationBadRequest
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.organisations[organisation.Data.ID]; exists {
+		return models.Organisation{}, organisations.ErrOrganisationConflict
+	}
+
+	data := copyOrganisationData(*organisation.Data)
+	data.WithVersion(0)
+	c.organisations[data.ID] = data
+	c.organisationOrder = append(c.organisationOrder, data.ID)
+
+	return *new(models.Organisation).WithData(copyOrganisationData(data)), nil
+}
+
+func (c *StatefulClient) FetchOrganisation(organisationID string, _ ...organisations.CallOption) (models.Organisation, error) {
+	if organisationID == "" {
+		return models.Organisation{}, organisations.ErrOrganisationInvalidParameters
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, exists := c.organisations[organisationID]
+	if !exists {
+		return models.Organisation{}, organisations.ErrOrganisationNotFound
+	}
+
+	return *new(models.Organisation).WithData(copyOrganisationData(data)), nil
+}
+
+func (c *StatefulClient) ListOrganisations(pageNumber int, pageSize int, _ ...organisations.CallOption) (models.OrganisationList, error) {
+	if pageNumber < 0 || pageSize <= 0 {
+		return models.OrganisationList{}, organisations.ErrOrganisationInvalidParameters
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	list := models.OrganisationList{
+		Data:  []models.OrganisationData{},
+		Links: &models.Links{},
+	}
+
+	for i := pageNumber * pageSize; i < len(c.organisationOrder) && len(list.Data) < pageSize; i++ {
+		list.Data = append(list.Data, copyOrganisationData(c.organisations[c.organisationOrder[i]]))
+	}
+
+	if (pageNumber+1)*pageSize < len(c.organisationOrder) {
+		list.Links.Next = fmt.Sprintf("page[number]=%d&page[size]=%d", pageNumber+1, pageSize)
+	}
+
+	return list, nil
+}
+
+// UpdateOrganisation replaces the attributes that are set and increments the
+// version, which must match the stored one.
+func (c *StatefulClient) UpdateOrganisation(organisation models.Organisation, _ ...organisations.CallOption) (models.Organisation, error) {
+	if organisation.Data == nil || organisation.Data.ID == "" {
+		return models.Organisation{}, organisations.ErrOrganisationInvalidParameters
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, exists := c.organisations[organisation.Data.ID]
+	if !exists {
+		return models.Organisation{}, organisations.ErrOrganisationNotFound
+	}
+
+	if organisation.Data.Version == nil || *organisation.Data.Version != *data.Version {
+		return models.Organisation{}, organisations.ErrOrganisationConflict
+	}
+
+	attributes := models.OrganisationAttributes{}
+	if data.Attributes != nil {
+		attributes = *data.Attributes
+	}
+
+	if update := organisation.Data.Attributes; update != nil {
+		if update.Name != "" {
+			attributes.Name = update.Name
+		}
+		if update.ParentID != "" {
+			attributes.ParentID = update.ParentID
+		}
+	}
+
+	data.WithAttributes(attributes).WithVersion(*data.Version + 1)
+	c.organisations[data.ID] = data
+
+	return *new(models.Organisation).WithData(copyOrganisationData(data)), nil
+}
+
+func (c *StatefulClient) CreateSubscription(subscription models.Subscription, _ ...subscriptions.CallOption) (models.Subscription, error) {
+	if subscription.Data == nil || subscription.Data.ID == "" || subscription.Data.Attributes == nil {
+		return models.Subscription{}, subscriptions.ErrSubscriptionBadRequest
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.subscriptions[subscription.Data.ID]; exists {
+		return models.Subscription{}, subscriptions.ErrSubscriptionConflict
+	}
+
+	data := *subscription.Data
+	attributes := *data.Attributes
+	data.Attributes = &attributes
+	data.WithVersion(0)
+
+	c.subscriptions[data.ID] = data
+	c.subscriptionOrder = append(c.subscriptionOrder, data.ID)
+
+	return *new(models.Subscription).WithData(data), nil
+}
+
+func (c *StatefulClient) ListSubscriptions(pageNumber int, pageSize int, _ ...subscriptions.CallOption) (models.SubscriptionList, error) {
+	if pageNumber < 0 || pageSize <= 0 {
+		return models.SubscriptionList{}, subscriptions.ErrSubscriptionInvalidParameters
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	list := models.SubscriptionList{
+		Data:  []models.SubscriptionData{},
+		Links: &models.Links{},
+	}
+
+	for i := pageNumber * pageSize; i < len(c.subscriptionOrder) && len(list.Data) < pageSize; i++ {
+		list.Data = append(list.Data, c.subscriptions[c.subscriptionOrder[i]])
+	}
+
+	if (pageNumber+1)*pageSize < len(c.subscriptionOrder) {
+		list.Links.Next = fmt.Sprintf("page[number]=%d&page[size]=%d", pageNumber+1, pageSize)
+	}
+
+	return list, nil
+}
+
+func (c *StatefulClient) DeleteSubscription(subscriptionID string, version int64, _ ...subscriptions.CallOption) error {
+	if subscriptionID == "" {
+		return subscriptions.ErrSubscriptionInvalidParameters
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, exists := c.subscriptions[subscriptionID]
+	if !exists {
+		return subscriptions.ErrSubscriptionNotFound
+	}
+
+	if *data.Version != version {
+		return subscriptions.ErrSubscriptionConflict
+	}
+
+	delete(c.subscriptions, subscriptionID)
+
+	for i, id := range c.subscriptionOrder {
+		if id == subscriptionID {
+			c.subscriptionOrder = append(c.subscriptionOrder[:i], c.subscriptionOrder[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+// Health always reports the in-memory api as up.
+func (c *StatefulClient) Health(context.Context) (models.Health, error) {
+	return models.Health{Status: models.HealthStatusUp}, nil
+}
+
+func (c *StatefulClient) WaitUntilReady(context.Context, time.Duration) error {
+	return nil
+}
+
+func copyAccountData(data models.AccountData) models.AccountData {
+	if data.Version != nil {
+		data.WithVersion(*data.Version)
+	}
+
+	if data.Attributes != nil {
+		attributes := *data.Attributes
+		attributes.Name = append([]string(nil), attributes.Name...)
+		attributes.AlternativeNames = append([]string(nil), attributes.AlternativeNames...)
+		data.Attributes = &attributes
+	}
+
+	return data
+}
+
+func copyPaymentData(data models.PaymentData) models.PaymentData {
+	if data.Version != nil {
+		data.WithVersion(*data.Version)
+	}
+
+	if data.Attributes != nil {
+		attributes := *data.Attributes
+		if attributes.DebtorParty != nil {
+			party := *attributes.DebtorParty
+			attributes.DebtorParty = &party
+		}
+		if attributes.BeneficiaryParty != nil {
+			party := *attributes.BeneficiaryParty
+			attributes.BeneficiaryParty = &party
+		}
+		data.Attributes = &attributes
+	}
+
+	return data
+}
+
+func copyOrganisationData(data models.OrganisationData) models.OrganisationData {
+	if data.Version != nil {
+		data.WithVersion(*data.Version)
+	}
+
+	if data.Attributes != nil {
+		data.WithAttributes(*data.Attributes)
+	}
+
+	return data
+}
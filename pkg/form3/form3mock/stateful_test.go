@@ -0,0 +1,172 @@
+package form3mock
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/organisations"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/payments"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/subscriptions"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatefulClient(t *testing.T) {
+	// Arrange
+	client := NewStatefulClient()
+	account := *new(models.Account).WithData(
+		*new(models.AccountData).
+			WithID(uuid.NewString()).
+			WithOrganisationID(uuid.NewString()).
+			WithType("accounts").
+			WithAttributes(
+				*new(models.AccountAttributes).
+					WithCountry("GB").
+					WithName([]string{"account name"}),
+			),
+	)
+
+	// Act & Assert
+	created, err := client.CreateAccount(account)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), *created.Data.Version)
+
+	_, err = client.CreateAccount(account)
+	assert.True(t, errors.Is(err, accounts.ErrAccountConflict))
+
+	_, err = client.CreateAccount(*new(models.Account).WithData(models.AccountData{ID: "invalid"}))
+	assert.True(t, errors.Is(err, accounts.ErrAccountBadRequest))
+
+	fetched, err := client.FetchAccount(account.Data.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created, fetched)
+
+	list, err := client.ListAccounts(0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []models.AccountData{*created.Data}, list.Data)
+	assert.False(t, list.HasNext())
+
+	err = client.DeleteAccount(account.Data.ID, 1)
+	assert.True(t, errors.Is(err, accounts.ErrAccountConflict))
+
+	err = client.DeleteAccount(account.Data.ID, 0)
+	assert.NoError(t, err)
+
+	_, err = client.FetchAccount(account.Data.ID)
+	assert.True(t, errors.Is(err, accounts.ErrAccountNotFound))
+}
+
+func TestStatefulClient_payments(t *testing.T) {
+	// Arrange
+	client := NewStatefulClient()
+	payment := *new(models.Payment).WithData(
+		*new(models.PaymentData).
+			WithID(uuid.NewString()).
+			WithOrganisationID(uuid.NewString()).
+			WithType("payments").
+			WithAttributes(*new(models.PaymentAttributes).WithAmount("10.00").WithCurrency("GBP")),
+	)
+	submission := *new(models.PaymentSubmission).WithData(*new(models.PaymentSubmissionData).WithID(uuid.NewString()))
+
+	// Act & Assert
+	created, err := client.CreatePayment(payment)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), *created.Data.Version)
+
+	_, err = client.CreatePayment(payment)
+	assert.True(t, errors.Is(err, payments.ErrPaymentConflict))
+
+	fetched, err := client.FetchPayment(payment.Data.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created, fetched)
+
+	list, err := client.ListPayments(0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []models.PaymentData{*created.Data}, list.Data)
+
+	submitted, err := client.SubmitPayment(payment.Data.ID, submission)
+	require.NoError(t, err)
+	assert.Equal(t, "accepted", submitted.Data.Attributes.Status)
+
+	_, err = client.SubmitPayment(payment.Data.ID, submission)
+	assert.True(t, errors.Is(err, payments.ErrPaymentConflict))
+
+	_, err = client.SubmitPayment(uuid.NewString(), submission)
+	assert.True(t, errors.Is(err, payments.ErrPaymentNotFound))
+}
+
+func TestStatefulClient_subscriptions(t *testing.T) {
+	// Arrange
+	client := NewStatefulClient()
+	subscription := *new(models.Subscription).WithData(
+		*new(models.SubscriptionData).
+			WithID(uuid.NewString()).
+			WithAttributes(*new(models.SubscriptionAttributes).
+				WithCallbackURI("https://example.com/webhooks").
+				WithEventType(models.EventTypeUpdated).
+				WithRecordType(models.RecordTypeAccounts)),
+	)
+
+	// Act & Assert
+	created, err := client.CreateSubscription(subscription)
+	require.NoError(t, err)
+
+	_, err = client.CreateSubscription(subscription)
+	assert.True(t, errors.Is(err, subscriptions.ErrSubscriptionConflict))
+
+	list, err := client.ListSubscriptions(0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []models.SubscriptionData{*created.Data}, list.Data)
+
+	err = client.DeleteSubscription(subscription.Data.ID, 1)
+	assert.True(t, errors.Is(err, subscriptions.ErrSubscriptionConflict))
+
+	err = client.DeleteSubscription(subscription.Data.ID, 0)
+	assert.NoError(t, err)
+
+	err = client.DeleteSubscription(subscription.Data.ID, 0)
+	assert.True(t, errors.Is(err, subscriptions.ErrSubscriptionNotFound))
+}
+
+func TestStatefulClient_organisations(t *testing.T) {
+	// Arrange
+	client := NewStatefulClient()
+	organisation := *new(models.Organisation).WithData(
+		*new(models.OrganisationData).
+			WithID(uuid.NewString()).
+			WithType("organisations").
+			WithAttributes(*new(models.OrganisationAttributes).WithName("name")),
+	)
+
+	// Act & Assert
+	created, err := client.CreateOrganisation(organisation)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), *created.Data.Version)
+
+	_, err = client.CreateOrganisation(organisation)
+	assert.True(t, errors.Is(err, organisations.ErrOrganisationConflict))
+
+	update := *new(models.Organisation).WithData(*new(models.OrganisationData).
+		WithID(organisation.Data.ID).
+		WithVersion(0).
+		WithAttributes(*new(models.OrganisationAttributes).WithName("new name")))
+
+	updated, err := client.UpdateOrganisation(update)
+	require.NoError(t, err)
+	assert.Equal(t, "new name", updated.Data.Attributes.Name)
+	assert.Equal(t, int64(1), *updated.Data.Version)
+
+	_, err = client.UpdateOrganisation(update)
+	assert.True(t, errors.Is(err, organisations.ErrOrganisationConflict))
+
+	fetched, err := client.FetchOrganisation(organisation.Data.ID)
+	require.NoError(t, err)
+	assert.Equal(t, updated, fetched)
+
+	list, err := client.ListOrganisations(0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []models.OrganisationData{*updated.Data}, list.Data)
+}
@@ -0,0 +1,288 @@
+package form3test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/google/uuid"
+)
+
+const (
+	_accountType = "accounts"
+
+	_defaultPageSize = 100
+)
+
+var (
+	_countryRegexp      = regexp.MustCompile(`^[A-Z]{2}$`)
+	_baseCurrencyRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+	_bankIDRegexp       = regexp.MustCompile(`^[A-Z0-9]{0,16}$`)
+	_bicRegexp          = regexp.MustCompile(`^([A-Z]{6}[A-Z0-9]{2}|[A-Z]{6}[A-Z0-9]{5})$`)
+	_ibanRegexp         = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{0,64}$`)
+)
+
+type (
+	accountResponse struct {
+		Data  models.AccountData `json:"data"`
+		Links links              `json:"links"`
+	}
+
+	accountListResponse struct {
+		Data  []models.AccountData `json:"data"`
+		Links links                `json:"links"`
+	}
+
+	links struct {
+		First string `json:"first,omitempty"`
+		Last  string `json:"last,omitempty"`
+		Next  string `json:"next,omitempty"`
+		Prev  string `json:"prev,omitempty"`
+		Self  string `json:"self"`
+	}
+)
+
+// AddAccount stores an account as if it had been created through the api.
+func (s *Server) AddAccount(data models.AccountData) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store(data)
+}
+
+// Accounts returns the stored accounts in creation order.
+func (s *Server) Accounts() []models.AccountData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	accounts := make([]models.AccountData, 0, len(s.order))
+	for _, id := range s.order {
+		accounts = append(accounts, s.accounts[id])
+	}
+
+	return accounts
+}
+
+func (s *Server) createAccount(w http.ResponseWriter, r *http.Request) {
+	var account models.Account
+	if err := json.NewDecoder(r.Body).Decode(&account); err != nil || account.Data == nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := ValidateAccount(*account.Data); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.accounts[account.Data.ID]; exists {
+		writeError(w, http.StatusConflict, "Account cannot be created as it violates a duplicate constraint")
+		return
+	}
+
+	stored := s.store(*account.Data)
+
+	writeJson(w, http.StatusCreated, accountResponse{
+		Data:  stored,
+		Links: links{Self: accountPath(stored.ID)},
+	})
+}
+
+func (s *Server) fetchAccount(w http.ResponseWriter, _ *http.Request, id string) {
+	if _, err := uuid.Parse(id); err != nil {
+		writeError(w, http.StatusBadRequest, "id is not a valid uuid")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, exists := s.accounts[id]
+	if !exists {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("record %s does not exist", id))
+		return
+	}
+
+	writeJson(w, http.StatusOK, accountResponse{
+		Data:  stored,
+		Links: links{Self: accountPath(id)},
+	})
+}
+
+func (s *Server) deleteAccount(w http.ResponseWriter, r *http.Request, id string) {
+	if _, err := uuid.Parse(id); err != nil {
+		writeError(w, http.StatusBadRequest, "id is not a valid uuid")
+		return
+	}
+
+	version, err := strconv.ParseInt(r.URL.Query().Get("version"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid version number")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, exists := s.accounts[id]
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if stored.Version == nil || *stored.Version != version {
+		writeError(w, http.StatusConflict, "invalid version")
+		return
+	}
+
+	delete(s.accounts, id)
+	for i, storedID := range s.order {
+		if storedID == id {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			break
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listAccounts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	pageNumber, pageSize := 0, _defaultPageSize
+	if value := query.Get("page[number]"); value != "" {
+		number, err := strconv.Atoi(value)
+		if err != nil || number < 0 {
+			writeError(w, http.StatusBadRequest, "invalid page number")
+			return
+		}
+		pageNumber = number
+	}
+	if value := query.Get("page[size]"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size <= 0 {
+			writeError(w, http.StatusBadRequest, "invalid page size")
+			return
+		}
+		pageSize = size
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data := make([]models.AccountData, 0, pageSize)
+	for i := pageNumber * pageSize; i < len(s.order) && len(data) < pageSize; i++ {
+		data = append(data, s.accounts[s.order[i]])
+	}
+
+	lastPage := 0
+	if len(s.order) > 0 {
+		lastPage = (len(s.order) - 1) / pageSize
+	}
+
+	pageLinks := links{
+		First: pagePath(0, pageSize),
+		Last:  pagePath(lastPage, pageSize),
+		Self:  pagePath(pageNumber, pageSize),
+	}
+	if pageNumber < lastPage {
+		pageLinks.Next = pagePath(pageNumber+1, pageSize)
+	}
+	if pageNumber > 0 {
+		pageLinks.Prev = pagePath(pageNumber-1, pageSize)
+	}
+
+	writeJson(w, http.StatusOK, accountListResponse{
+		Data:  data,
+		Links: pageLinks,
+	})
+}
+
+func (s *Server) store(data models.AccountData) models.AccountData {
+	now := time.Now().UTC()
+
+	data.WithVersion(0)
+	data.CreatedOn = &now
+	data.ModifiedOn = &now
+	if data.Attributes != nil {
+		attributes := *data.Attributes
+		data.Attributes = &attributes
+	}
+
+	if _, exists := s.accounts[data.ID]; !exists {
+		s.order = append(s.order, data.ID)
+	}
+	s.accounts[data.ID] = data
+
+	return data
+}
+
+// ValidateAccount applies the same validations as the real api when creating
+// an account.
+func ValidateAccount(data models.AccountData) error {
+	var failures []string
+
+	if _, err := uuid.Parse(data.ID); err != nil {
+		failures = append(failures, "id in body must be of type uuid")
+	}
+
+	if _, err := uuid.Parse(data.OrganisationID); err != nil {
+		failures = append(failures, "organisation_id in body must be of type uuid")
+	}
+
+	if data.Type != _accountType {
+		failures = append(failures, "type in body should be one of [accounts]")
+	}
+
+	if data.Attributes == nil {
+		failures = append(failures, "attributes in body is required")
+	} else {
+		if data.Attributes.Country == nil {
+			failures = append(failures, "country in body is required")
+		} else if !_countryRegexp.MatchString(*data.Attributes.Country) {
+			failures = append(failures, "country in body should match '^[A-Z]{2}$'")
+		}
+
+		if len(data.Attributes.Name) == 0 {
+			failures = append(failures, "name in body is required")
+		}
+
+		failures = append(failures, validatePattern("base_currency", data.Attributes.BaseCurrency, _baseCurrencyRegexp)...)
+		failures = append(failures, validatePattern("bank_id", data.Attributes.BankID, _bankIDRegexp)...)
+		failures = append(failures, validatePattern("bic", data.Attributes.Bic, _bicRegexp)...)
+		failures = append(failures, validatePattern("iban", data.Attributes.Iban, _ibanRegexp)...)
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	sort.Strings(failures)
+
+	return fmt.Errorf("%w:\n%s", ErrValidationFailure, strings.Join(failures, "\n"))
+}
+
+func validatePattern(field string, value string, pattern *regexp.Regexp) []string {
+	if value == "" || pattern.MatchString(value) {
+		return nil
+	}
+
+	return []string{fmt.Sprintf("%s in body should match '%s'", field, pattern.String())}
+}
+
+func accountPath(id string) string {
+	return fmt.Sprintf("%s/%s", _pathAccounts, id)
+}
+
+func pagePath(number, size int) string {
+	return fmt.Sprintf("%s?page[number]=%d&page[size]=%d", _pathAccounts, number, size)
+}
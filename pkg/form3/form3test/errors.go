@@ -0,0 +1,11 @@
+package form3test
+
+import "errors"
+
+var (
+	ErrValidationFailure = errors.New("validation failure list")
+
+	ErrLoadCassette           = errors.New("error loading cassette")
+	ErrSaveCassette           = errors.New("error saving cassette")
+	ErrInteractionNotRecorded = errors.New("interaction not recorded in cassette")
+)
@@ -0,0 +1,297 @@
+package form3test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+)
+
+const (
+	EndpointCreateAccount = "create_account"
+	EndpointFetchAccount  = "fetch_account"
+	EndpointDeleteAccount = "delete_account"
+	EndpointListAccounts  = "list_accounts"
+	EndpointHealth        = "health"
+
+	// AnyEndpoint matches requests to every endpoint.
+	AnyEndpoint = ""
+
+	_malformedBody = `{"data":{"id":`
+)
+
+type (
+	// Fault describes how a single request misbehaves. Latency is applied
+	// before any other fault.
+	Fault struct {
+		Latency         time.Duration
+		Timeout         bool
+		ConnectionReset bool
+		StatusCode      int
+		VersionConflict bool
+		TruncatedBody   bool
+		MalformedBody   bool
+	}
+
+	// FaultInjector decides which fault, if any, is applied to each request.
+	// Scripted faults are consumed in order before probabilistic ones are
+	// evaluated.
+	FaultInjector struct {
+		mu      sync.Mutex
+		random  *rand.Rand
+		scripts map[string][]Fault
+		rules   []faultRule
+	}
+
+	faultRule struct {
+		endpoint    string
+		probability float64
+		fault       Fault
+	}
+
+	faultyHttpClient struct {
+		injector *FaultInjector
+		next     endpoints.IHttpClient
+	}
+
+	timeoutError struct{}
+)
+
+func NewFaultInjector(seed int64) *FaultInjector {
+	return &FaultInjector{
+		random:  rand.New(rand.NewSource(seed)),
+		scripts: make(map[string][]Fault),
+	}
+}
+
+// Script queues faults for the next requests to an endpoint, one per request.
+func (f *FaultInjector) Script(endpoint string, faults ...Fault) *FaultInjector {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.scripts[endpoint] = append(f.scripts[endpoint], faults...)
+	return f
+}
+
+// WithProbability applies a fault to requests to an endpoint with the given
+// probability, between 0 and 1.
+func (f *FaultInjector) WithProbability(endpoint string, probability float64, fault Fault) *FaultInjector {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.rules = append(f.rules, faultRule{endpoint: endpoint, probability: probability, fault: fault})
+	return f
+}
+
+// HttpClient wraps an http client so that requests go through the injector.
+func (f *FaultInjector) HttpClient(next endpoints.IHttpClient) endpoints.IHttpClient {
+	return faultyHttpClient{injector: f, next: next}
+}
+
+// Handler wraps an http handler so that requests go through the injector.
+func (f *FaultInjector) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fault, exists := f.next(endpointName(r))
+		if !exists {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		f.serveFault(w, r, next, fault)
+	})
+}
+
+func (f *FaultInjector) next(endpoint string) (Fault, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, key := range []string{endpoint, AnyEndpoint} {
+		if script := f.scripts[key]; len(script) > 0 {
+			f.scripts[key] = script[1:]
+			return script[0], true
+		}
+	}
+
+	for _, rule := range f.rules {
+		if rule.endpoint != AnyEndpoint && rule.endpoint != endpoint {
+			continue
+		}
+
+		if f.random.Float64() < rule.probability {
+			return rule.fault, true
+		}
+	}
+
+	return Fault{}, false
+}
+
+func (c faultyHttpClient) Do(req *http.Request) (*http.Response, error) {
+	fault, exists := c.injector.next(endpointName(req))
+	if !exists {
+		return c.next.Do(req)
+	}
+
+	if err := sleep(req.Context(), fault.Latency); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case fault.Timeout:
+		return nil, &url.Error{Op: req.Method, URL: req.URL.String(), Err: timeoutError{}}
+	case fault.ConnectionReset:
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	case fault.VersionConflict:
+		return faultResponse(req, http.StatusConflict, `{"error_message":"invalid version"}`), nil
+	case fault.StatusCode != 0:
+		return faultResponse(req, fault.StatusCode, statusBody(fault.StatusCode)), nil
+	}
+
+	res, err := c.next.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	body = corruptBody(fault, body)
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	res.ContentLength = int64(len(body))
+
+	return res, nil
+}
+
+func (f *FaultInjector) serveFault(w http.ResponseWriter, r *http.Request, next http.Handler, fault Fault) {
+	if err := sleep(r.Context(), fault.Latency); err != nil {
+		return
+	}
+
+	switch {
+	case fault.Timeout:
+		<-r.Context().Done()
+		return
+	case fault.ConnectionReset:
+		resetConnection(w)
+		return
+	case fault.VersionConflict:
+		writeError(w, http.StatusConflict, "invalid version")
+		return
+	case fault.StatusCode != 0:
+		w.WriteHeader(fault.StatusCode)
+		_, _ = io.WriteString(w, statusBody(fault.StatusCode))
+		return
+	}
+
+	recorder := httptest.NewRecorder()
+	next.ServeHTTP(recorder, r)
+
+	for key, values := range recorder.Header() {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(recorder.Code)
+	_, _ = w.Write(corruptBody(fault, recorder.Body.Bytes()))
+}
+
+func corruptBody(fault Fault, body []byte) []byte {
+	switch {
+	case fault.MalformedBody:
+		return []byte(_malformedBody)
+	case fault.TruncatedBody:
+		return body[:len(body)/2]
+	default:
+		return body
+	}
+}
+
+func resetConnection(w http.ResponseWriter) {
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	conn, _, err := hijacker.Hijack()
+	if err != nil {
+		return
+	}
+
+	if tcpConn, ok := conn.(*net.TCPConn); ok {
+		_ = tcpConn.SetLinger(0)
+	}
+	_ = conn.Close()
+}
+
+func faultResponse(req *http.Request, statusCode int, body string) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Header:        http.Header{"Content-Type": []string{"application/vnd.api+json"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func statusBody(statusCode int) string {
+	return fmt.Sprintf(`{"error_message":"%s"}`, strings.ToLower(http.StatusText(statusCode)))
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	if duration <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func endpointName(r *http.Request) string {
+	path := r.URL.Path
+	if i := strings.Index(path, _pathAccounts); i >= 0 {
+		path = path[i:]
+	}
+
+	switch {
+	case path == _pathAccounts && r.Method == http.MethodPost:
+		return EndpointCreateAccount
+	case path == _pathAccounts && r.Method == http.MethodGet:
+		return EndpointListAccounts
+	case strings.HasPrefix(path, _pathAccounts+"/") && r.Method == http.MethodGet:
+		return EndpointFetchAccount
+	case strings.HasPrefix(path, _pathAccounts+"/") && r.Method == http.MethodDelete:
+		return EndpointDeleteAccount
+	case strings.HasSuffix(path, _pathHealth) && r.Method == http.MethodGet:
+		return EndpointHealth
+	default:
+		return AnyEndpoint
+	}
+}
+
+func (timeoutError) Error() string {
+	return "i/o timeout"
+}
+
+func (timeoutError) Timeout() bool {
+	return true
+}
@@ -0,0 +1,152 @@
+package form3test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaultInjector_HttpClient(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	created := newTestAccount()
+	server.AddAccount(*created.Data)
+
+	tests := []struct {
+		name        string
+		fault       Fault
+		assertError func(t *testing.T, err error)
+	}{
+		{
+			name: "given a version conflict fault" +
+				"when fetching account" +
+				"then return conflict",
+			fault: Fault{VersionConflict: true},
+			assertError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, accounts.ErrAccountConflict))
+			},
+		},
+		{
+			name: "given a status code fault" +
+				"when fetching account" +
+				"then return error",
+			fault: Fault{StatusCode: http.StatusServiceUnavailable},
+			assertError: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "status code 503")
+			},
+		},
+		{
+			name: "given a malformed body fault" +
+				"when fetching account" +
+				"then return error",
+			fault: Fault{MalformedBody: true},
+			assertError: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "error unmarshalling response")
+			},
+		},
+		{
+			name: "given a truncated body fault" +
+				"when fetching account" +
+				"then return error",
+			fault: Fault{TruncatedBody: true},
+			assertError: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "error unmarshalling response")
+			},
+		},
+		{
+			name: "given a timeout fault" +
+				"when fetching account" +
+				"then return error",
+			fault: Fault{Timeout: true},
+			assertError: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "i/o timeout")
+			},
+		},
+		{
+			name: "given a connection reset fault" +
+				"when fetching account" +
+				"then return error",
+			fault: Fault{ConnectionReset: true},
+			assertError: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "connection reset")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			injector := NewFaultInjector(1).Script(EndpointFetchAccount, tt.fault)
+			client, err := server.Client(form3.WithHttpClient(injector.HttpClient(http.DefaultClient)))
+			require.NoError(t, err)
+
+			// Act
+			_, err = client.FetchAccount(created.Data.ID)
+
+			// Assert
+			tt.assertError(t, err)
+
+			_, err = client.FetchAccount(created.Data.ID)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestFaultInjector_Handler(t *testing.T) {
+	// Arrange
+	injector := NewFaultInjector(1).
+		Script(EndpointCreateAccount, Fault{StatusCode: http.StatusTooManyRequests}).
+		Script(EndpointFetchAccount, Fault{ConnectionReset: true}, Fault{Latency: 10 * time.Millisecond})
+
+	server := NewServer(WithFaultInjector(injector))
+	defer server.Close()
+
+	// keep alives are disabled so that the transport does not transparently
+	// retry requests over a reset connection
+	httpClient := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+	client, err := server.Client(form3.WithHttpClient(httpClient))
+	require.NoError(t, err)
+
+	account := newTestAccount()
+
+	// Act & Assert
+	_, err = client.CreateAccount(account)
+	assert.ErrorContains(t, err, "status code 429")
+
+	_, err = client.CreateAccount(account)
+	require.NoError(t, err)
+
+	_, err = client.FetchAccount(account.Data.ID)
+	assert.Error(t, err)
+
+	start := time.Now()
+	_, err = client.FetchAccount(account.Data.ID)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+}
+
+func TestFaultInjector_WithProbability(t *testing.T) {
+	// Arrange
+	first := NewFaultInjector(42).WithProbability(AnyEndpoint, 0.5, Fault{StatusCode: 500})
+	second := NewFaultInjector(42).WithProbability(AnyEndpoint, 0.5, Fault{StatusCode: 500})
+
+	// Act
+	var firstFaults, secondFaults []bool
+	for i := 0; i < 20; i++ {
+		_, firstExists := first.next(EndpointFetchAccount)
+		_, secondExists := second.next(EndpointFetchAccount)
+		firstFaults = append(firstFaults, firstExists)
+		secondFaults = append(secondFaults, secondExists)
+	}
+
+	// Assert
+	assert.Equal(t, firstFaults, secondFaults)
+	assert.Contains(t, firstFaults, true)
+	assert.Contains(t, firstFaults, false)
+}
@@ -0,0 +1,294 @@
+package form3test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+)
+
+const (
+	ModeReplay RecorderMode = iota
+	ModeRecord
+)
+
+var (
+	_defaultRedactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Signature"}
+	_defaultVolatileHeaders = []string{"Date", "Idempotency-Key", "Traceparent", "Tracestate", "User-Agent", "X-Request-Id"}
+)
+
+type (
+	RecorderMode int
+
+	RecorderOption func(*Recorder)
+
+	// Recorder is an http client that records interactions with the api into
+	// a cassette file, or replays them from it without any network access.
+	Recorder struct {
+		path            string
+		mode            RecorderMode
+		next            endpoints.IHttpClient
+		redactedHeaders []string
+		volatileHeaders []string
+		redactBody      func([]byte) []byte
+
+		mu       sync.Mutex
+		cassette Cassette
+		used     []bool
+	}
+
+	Cassette struct {
+		Interactions []Interaction `json:"interactions"`
+	}
+
+	Interaction struct {
+		Request  RecordedRequest  `json:"request"`
+		Response RecordedResponse `json:"response"`
+	}
+
+	RecordedRequest struct {
+		Method  string      `json:"method"`
+		URL     string      `json:"url"`
+		Headers http.Header `json:"headers,omitempty"`
+		Body    string      `json:"body,omitempty"`
+	}
+
+	RecordedResponse struct {
+		StatusCode int         `json:"status_code"`
+		Headers    http.Header `json:"headers,omitempty"`
+		Body       string      `json:"body,omitempty"`
+	}
+)
+
+func NewRecorder(path string, mode RecorderMode, opts ...RecorderOption) (*Recorder, error) {
+	r := &Recorder{
+		path:            path,
+		mode:            mode,
+		next:            http.DefaultClient,
+		redactedHeaders: _defaultRedactedHeaders,
+		volatileHeaders: _defaultVolatileHeaders,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == ModeRecord {
+		return r, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, endpoints.WrapError(ErrLoadCassette, err)
+	}
+
+	if err = json.Unmarshal(content, &r.cassette); err != nil {
+		return nil, endpoints.WrapError(ErrLoadCassette, err)
+	}
+
+	r.used = make([]bool, len(r.cassette.Interactions))
+
+	return r, nil
+}
+
+// WithRecordingHttpClient sets the http client used to reach the api while
+// recording.
+func WithRecordingHttpClient(next endpoints.IHttpClient) RecorderOption {
+	return func(r *Recorder) {
+		r.next = next
+	}
+}
+
+// WithRedactedHeaders adds headers whose values are replaced in the cassette.
+func WithRedactedHeaders(headers ...string) RecorderOption {
+	return func(r *Recorder) {
+		r.redactedHeaders = append(append([]string{}, r.redactedHeaders...), headers...)
+	}
+}
+
+// WithVolatileHeaders adds headers that are left out of the cassette.
+func WithVolatileHeaders(headers ...string) RecorderOption {
+	return func(r *Recorder) {
+		r.volatileHeaders = append(append([]string{}, r.volatileHeaders...), headers...)
+	}
+}
+
+// WithBodyRedaction transforms request and response bodies before they are
+// saved, e.g. logging.MaskJSON.
+func WithBodyRedaction(redact func([]byte) []byte) RecorderOption {
+	return func(r *Recorder) {
+		r.redactBody = redact
+	}
+}
+
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, requestBody)
+	}
+
+	return r.record(req, requestBody)
+}
+
+// Save writes the recorded interactions to the cassette file.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	content, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return endpoints.WrapError(ErrSaveCassette, err)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return endpoints.WrapError(ErrSaveCassette, err)
+	}
+
+	if err = os.WriteFile(r.path, append(content, '\n'), 0644); err != nil {
+		return endpoints.WrapError(ErrSaveCassette, err)
+	}
+
+	return nil
+}
+
+func (r *Recorder) record(req *http.Request, requestBody []byte) (*http.Response, error) {
+	res, err := r.next.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: r.sanitiseHeaders(req.Header),
+			Body:    string(r.sanitiseBody(requestBody)),
+		},
+		Response: RecordedResponse{
+			StatusCode: res.StatusCode,
+			Headers:    r.sanitiseHeaders(res.Header),
+			Body:       string(r.sanitiseBody(responseBody)),
+		},
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+
+	return res, nil
+}
+
+func (r *Recorder) replay(req *http.Request, requestBody []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	body := string(r.sanitiseBody(requestBody))
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !matches(interaction.Request, req, body) {
+			continue
+		}
+
+		r.used[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Header:        interaction.Response.Headers.Clone(),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotRecorded, req.Method, req.URL.String())
+}
+
+func (r *Recorder) sanitiseHeaders(headers http.Header) http.Header {
+	sanitised := headers.Clone()
+
+	for _, header := range r.volatileHeaders {
+		sanitised.Del(header)
+	}
+
+	for _, header := range r.redactedHeaders {
+		if sanitised.Get(header) != "" {
+			sanitised.Set(header, "[REDACTED]")
+		}
+	}
+
+	if len(sanitised) == 0 {
+		return nil
+	}
+
+	return sanitised
+}
+
+func (r *Recorder) sanitiseBody(body []byte) []byte {
+	if r.redactBody == nil || len(body) == 0 {
+		return body
+	}
+
+	return r.redactBody(body)
+}
+
+func matches(recorded RecordedRequest, req *http.Request, body string) bool {
+	return recorded.Method == req.Method &&
+		recorded.URL == req.URL.String() &&
+		equalBodies(recorded.Body, body)
+}
+
+func equalBodies(recorded, body string) bool {
+	if recorded == body {
+		return true
+	}
+
+	var recordedJson, bodyJson interface{}
+	if json.Unmarshal([]byte(recorded), &recordedJson) != nil || json.Unmarshal([]byte(body), &bodyJson) != nil {
+		return false
+	}
+
+	recordedBytes, _ := json.Marshal(recordedJson)
+	bodyBytes, _ := json.Marshal(bodyJson)
+
+	return bytes.Equal(recordedBytes, bodyBytes)
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
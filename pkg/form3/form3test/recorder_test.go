@@ -0,0 +1,78 @@
+package form3test
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3"
+	"github.com/francorosatti/form3-api-client/pkg/form3/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	// Arrange
+	server := NewServer()
+	cassette := filepath.Join(t.TempDir(), "cassettes", "accounts.json")
+	account := newTestAccount()
+
+	recorder, err := NewRecorder(cassette, ModeRecord, WithVolatileHeaders("Content-Length"))
+	require.NoError(t, err)
+
+	client, err := form3.NewClient(form3.EnvironmentLocal, form3.WithHost(server.URL), form3.WithHttpClient(recorder))
+	require.NoError(t, err)
+
+	created, err := client.CreateAccount(account)
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save())
+
+	server.Close()
+
+	// Act
+	replayer, err := NewRecorder(cassette, ModeReplay)
+	require.NoError(t, err)
+
+	client, err = form3.NewClient(form3.EnvironmentLocal, form3.WithHost(server.URL), form3.WithHttpClient(replayer))
+	require.NoError(t, err)
+
+	replayed, err := client.CreateAccount(account)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, created, replayed)
+
+	_, err = client.CreateAccount(account)
+	assert.ErrorContains(t, err, ErrInteractionNotRecorded.Error())
+}
+
+func TestRecorder_sanitise(t *testing.T) {
+	// Arrange
+	recorder, err := NewRecorder("", ModeRecord, WithBodyRedaction(logging.MaskJSON))
+	require.NoError(t, err)
+
+	headers := http.Header{
+		"Authorization": []string{"secret"},
+		"Date":          []string{"today"},
+		"Content-Type":  []string{"application/json"},
+	}
+
+	// Act
+	gotHeaders := recorder.sanitiseHeaders(headers)
+	gotBody := recorder.sanitiseBody([]byte(`{"iban":"GB00"}`))
+
+	// Assert
+	assert.Equal(t, http.Header{
+		"Authorization": []string{"[REDACTED]"},
+		"Content-Type":  []string{"application/json"},
+	}, gotHeaders)
+	assert.Equal(t, `{"iban":"[REDACTED]"}`, string(gotBody))
+}
+
+func TestNewRecorder_missingCassette(t *testing.T) {
+	// Act
+	_, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrLoadCassette)
+}
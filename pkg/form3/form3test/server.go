@@ -0,0 +1,106 @@
+package form3test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+)
+
+const (
+	_pathAccounts = "/v1/organisation/accounts"
+	_pathHealth   = "/v1/health"
+)
+
+type ServerOption func(*Server)
+
+// Server is an in-memory fake of the form3 account api. It mirrors the
+// validations, status codes and error messages of the real api.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	accounts map[string]models.AccountData
+	order    []string
+	faults   *FaultInjector
+}
+
+func NewServer(opts ...ServerOption) *Server {
+	s := &Server{
+		accounts: make(map[string]models.AccountData),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	var handler http.Handler = http.HandlerFunc(s.route)
+	if s.faults != nil {
+		handler = s.faults.Handler(handler)
+	}
+
+	s.Server = httptest.NewServer(handler)
+
+	return s
+}
+
+func WithFaultInjector(faults *FaultInjector) ServerOption {
+	return func(s *Server) {
+		s.faults = faults
+	}
+}
+
+// Client returns a form3 client pointed at the fake server.
+func (s *Server) Client(opts ...form3.Option) (form3.IClient, error) {
+	return form3.NewClient(form3.EnvironmentLocal, append([]form3.Option{form3.WithHost(s.URL)}, opts...)...)
+}
+
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.accounts = make(map[string]models.AccountData)
+	s.order = nil
+}
+
+func (s *Server) route(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == _pathAccounts:
+		switch r.Method {
+		case http.MethodPost:
+			s.createAccount(w, r)
+		case http.MethodGet:
+			s.listAccounts(w, r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	case strings.HasPrefix(r.URL.Path, _pathAccounts+"/"):
+		id := strings.TrimPrefix(r.URL.Path, _pathAccounts+"/")
+		switch r.Method {
+		case http.MethodGet:
+			s.fetchAccount(w, r, id)
+		case http.MethodDelete:
+			s.deleteAccount(w, r, id)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	case r.URL.Path == _pathHealth && r.Method == http.MethodGet:
+		writeJson(w, http.StatusOK, models.Health{Status: models.HealthStatusUp})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func writeJson(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/vnd.api+json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJson(w, statusCode, models.ErrorDocument{ErrorMessage: message})
+}
@@ -0,0 +1,187 @@
+package form3test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3"
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+	"github.com/francorosatti/form3-api-client/pkg/form3/codec"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_CreateAccount(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	require.NoError(t, err)
+
+	existing := newTestAccount()
+	server.AddAccount(*existing.Data)
+
+	tests := []struct {
+		name        string
+		account     models.Account
+		expectedErr error
+	}{
+		{
+			name: "given a valid account" +
+				"when creating account" +
+				"then return created account",
+			account:     newTestAccount(),
+			expectedErr: nil,
+		},
+		{
+			name: "given an existing account id" +
+				"when creating account" +
+				"then return conflict",
+			account:     existing,
+			expectedErr: accounts.ErrAccountConflict,
+		},
+		{
+			name: "given an account without name" +
+				"when creating account" +
+				"then return bad request",
+			account: func() models.Account {
+				account := newTestAccount()
+				account.Data.Attributes.Name = nil
+				return account
+			}(),
+			expectedErr: accounts.ErrAccountBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got, err := client.CreateAccount(tt.account)
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+			if tt.expectedErr == nil {
+				assert.Equal(t, tt.account.Data.ID, got.Data.ID)
+				assert.Equal(t, int64(0), *got.Data.Version)
+			}
+		})
+	}
+}
+
+func TestServer_FetchAndDeleteAccount(t *testing.T) {
+	// Arrange
+	server := NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	require.NoError(t, err)
+
+	created, err := client.CreateAccount(newTestAccount())
+	require.NoError(t, err)
+
+	// Act & Assert
+	fetched, err := client.FetchAccount(created.Data.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created, fetched)
+
+	_, err = client.FetchAccount("invalid_id")
+	assert.True(t, errors.Is(err, accounts.ErrAccountBadRequest))
+
+	err = client.DeleteAccount(created.Data.ID, 1)
+	assert.True(t, errors.Is(err, accounts.ErrAccountConflict))
+
+	err = client.DeleteAccount(created.Data.ID, 0)
+	assert.NoError(t, err)
+
+	_, err = client.FetchAccount(created.Data.ID)
+	assert.True(t, errors.Is(err, accounts.ErrAccountNotFound))
+
+	err = client.DeleteAccount(created.Data.ID, 0)
+	assert.True(t, errors.Is(err, accounts.ErrAccountNotFound))
+}
+
+func TestServer_ListAccounts(t *testing.T) {
+	// Arrange
+	server := NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		server.AddAccount(*newTestAccount().Data)
+	}
+
+	// Act
+	first, firstErr := client.ListAccounts(0, 2)
+	second, secondErr := client.ListAccounts(1, 2)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Len(t, first.Data, 2)
+	assert.True(t, first.HasNext())
+	assert.Len(t, second.Data, 1)
+	assert.Equal(t, server.Accounts()[2].ID, second.Data[0].ID)
+	assert.False(t, second.HasNext())
+	assert.NotEmpty(t, second.Links.Prev)
+}
+
+func TestServer_strictCodec(t *testing.T) {
+	// Arrange
+	server := NewServer()
+	defer server.Close()
+
+	client, err := server.Client(form3.WithCodec(codec.JSON{DisallowUnknownFields: true}))
+	require.NoError(t, err)
+
+	// Act
+	created, createErr := client.CreateAccount(newTestAccount())
+	fetched, fetchErr := client.FetchAccount(created.Data.ID)
+	list, listErr := client.ListAccounts(0, 10)
+
+	// Assert
+	require.NoError(t, createErr)
+	require.NoError(t, fetchErr)
+	require.NoError(t, listErr)
+	assert.NotNil(t, fetched.Data.CreatedOn)
+	assert.NotNil(t, fetched.Data.ModifiedOn)
+	assert.Equal(t, []models.AccountData{*fetched.Data}, list.Data)
+}
+
+func TestServer_Health(t *testing.T) {
+	// Arrange
+	server := NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	require.NoError(t, err)
+
+	// Act
+	health, healthErr := client.Health(context.Background())
+	readyErr := client.WaitUntilReady(context.Background(), time.Millisecond)
+
+	// Assert
+	require.NoError(t, healthErr)
+	require.NoError(t, readyErr)
+	assert.True(t, health.IsUp())
+}
+
+func newTestAccount() models.Account {
+	return *new(models.Account).WithData(
+		*new(models.AccountData).
+			WithID(uuid.NewString()).
+			WithOrganisationID(uuid.NewString()).
+			WithType("accounts").
+			WithAttributes(
+				*new(models.AccountAttributes).
+					WithCountry("GB").
+					WithBankID("123456").
+					WithBic("NWBKGB22").
+					WithName([]string{"account name"}),
+			),
+	)
+}
@@ -0,0 +1,15 @@
+package form3
+
+import (
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+)
+
+// destructiveOperationGuard blocks the account operations that cannot be
+// undone. Bulk deletes go through DeleteAccount and are blocked as well.
+type destructiveOperationGuard struct {
+	accounts.IAccountClient
+}
+
+func (g destructiveOperationGuard) DeleteAccount(string, int64, ...accounts.CallOption) error {
+	return ErrDestructiveOperationBlocked
+}
@@ -0,0 +1,14 @@
+package endpoints
+
+const (
+	// HeaderIdempotencyKey lets the api recognise the retries of a mutating
+	// request, so that a retried POST does not create a resource twice.
+	HeaderIdempotencyKey = "Idempotency-Key"
+
+	// HeaderRequestID correlates a call with the api logs. Every attempt of
+	// a call sends the same id.
+	HeaderRequestID = "X-Request-Id"
+
+	// MaxBodySize bounds the response bodies read from the api.
+	MaxBodySize = 10 << 20
+)
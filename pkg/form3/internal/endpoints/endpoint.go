@@ -2,13 +2,29 @@ package endpoints
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/logging"
+	"github.com/francorosatti/form3-api-client/pkg/form3/metadata"
+	"github.com/francorosatti/form3-api-client/pkg/form3/metrics"
+	"github.com/francorosatti/form3-api-client/pkg/form3/retry"
+	"github.com/google/uuid"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type (
+	Option func(*endpoint)
+
 	RequestOption func(*requestOptions)
 
 	IEndpoint interface {
@@ -20,23 +36,96 @@ type (
 	}
 
 	endpoint struct {
+		name       string
 		httpClient IHttpClient
 		urlFormat  string
 		method     string
+		tracer     trace.Tracer
+		propagator propagation.TextMapPropagator
+		metrics    metrics.ICollector
+		logger     *logging.Logger
 	}
 
 	requestOptions struct {
+		ctx         context.Context
 		queryParams map[string]string
 		params      map[string]interface{}
+		headers     http.Header
 		body        []byte
+		stats       *Stats
+		timeout     time.Duration
+		retryPolicy *retry.Policy
+	}
+
+	// Stats are the figures of a request that its response does not carry.
+	Stats struct {
+		RequestID string
+		Attempts  int
+		BytesSent int64
 	}
+
+	// call is the state of a request shared with the http clients that do
+	// its attempts.
+	call struct {
+		endpoint endpoint
+		attempts int
+	}
+
+	callKey        struct{}
+	timeoutKey     struct{}
+	retryPolicyKey struct{}
 )
 
-func NewEndpoint(client IHttpClient, url string, method string) IEndpoint {
-	return endpoint{
+func NewEndpoint(client IHttpClient, url string, method string, opts ...Option) IEndpoint {
+	e := endpoint{
 		httpClient: client,
 		urlFormat:  url,
 		method:     method,
+		tracer:     trace.NewNoopTracerProvider().Tracer(""),
+		propagator: propagation.NewCompositeTextMapPropagator(),
+		metrics:    metrics.NoopCollector{},
+	}
+
+	for _, opt := range opts {
+		opt(&e)
+	}
+
+	return e
+}
+
+func WithName(name string) Option {
+	return func(e *endpoint) {
+		e.name = name
+	}
+}
+
+func WithMetrics(collector metrics.ICollector) Option {
+	return func(e *endpoint) {
+		e.metrics = collector
+	}
+}
+
+func WithLogger(logger *logging.Logger) Option {
+	return func(e *endpoint) {
+		e.logger = logger
+	}
+}
+
+func WithTracer(tracer trace.Tracer) Option {
+	return func(e *endpoint) {
+		e.tracer = tracer
+	}
+}
+
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(e *endpoint) {
+		e.propagator = propagator
+	}
+}
+
+func WithContext(ctx context.Context) RequestOption {
+	return func(options *requestOptions) {
+		options.ctx = ctx
 	}
 }
 
@@ -52,12 +141,93 @@ func WithParam(key string, value interface{}) RequestOption {
 	}
 }
 
+func WithHeader(key, value string) RequestOption {
+	return func(options *requestOptions) {
+		options.headers.Set(key, value)
+	}
+}
+
+// WithIdempotencyKey replaces the idempotency key generated for mutating
+// requests, e.g. with a business identifier.
+func WithIdempotencyKey(key string) RequestOption {
+	return WithHeader(HeaderIdempotencyKey, key)
+}
+
 func WithBody(body []byte) RequestOption {
 	return func(options *requestOptions) {
 		options.body = body
 	}
 }
 
+// WithStats fills stats once the request is done.
+func WithStats(stats *Stats) RequestOption {
+	return func(options *requestOptions) {
+		options.stats = stats
+	}
+}
+
+// WithTimeout replaces the timeout of every attempt of the request, for the
+// http clients made with NewTimeoutHttpClient.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(options *requestOptions) {
+		options.timeout = timeout
+	}
+}
+
+// WithRetryPolicy replaces the retry policy of the request, for the http
+// clients that retry requests.
+func WithRetryPolicy(policy retry.Policy) RequestOption {
+	return func(options *requestOptions) {
+		options.retryPolicy = &policy
+	}
+}
+
+func TimeoutFromContext(ctx context.Context) (time.Duration, bool) {
+	timeout, ok := ctx.Value(timeoutKey{}).(time.Duration)
+	return timeout, ok
+}
+
+func RetryPolicyFromContext(ctx context.Context) (retry.Policy, bool) {
+	policy, ok := ctx.Value(retryPolicyKey{}).(retry.Policy)
+	return policy, ok
+}
+
+// DoAttempt does an attempt of req with client in its own span, which
+// carries the attempt number, and counts the retries of the endpoint. Http
+// clients that retry requests do every attempt with it.
+func DoAttempt(client IHttpClient, req *http.Request) (*http.Response, error) {
+	c, ok := req.Context().Value(callKey{}).(*call)
+	if !ok {
+		return client.Do(req)
+	}
+
+	c.attempts++
+	if c.attempts > 1 {
+		c.endpoint.metrics.IncRetries(c.endpoint.name)
+	}
+
+	ctx, span := c.endpoint.tracer.Start(req.Context(), fmt.Sprintf("HTTP %s attempt", c.endpoint.method),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.Int("http.attempt", c.attempts)),
+	)
+	defer span.End()
+
+	req = req.WithContext(ctx)
+	c.endpoint.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, recordError(span, err)
+	}
+
+	span.SetAttributes(attribute.Int("http.status_code", res.StatusCode))
+	if res.StatusCode >= 500 {
+		span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+	}
+
+	return res, nil
+}
+
 func (e endpoint) Do(opts ...RequestOption) (*http.Response, error) {
 	options := defaultRequestOptions()
 
@@ -65,9 +235,29 @@ func (e endpoint) Do(opts ...RequestOption) (*http.Response, error) {
 		opt(&options)
 	}
 
-	url, err := e.buildUrl(options.params)
+	ctx, span := e.tracer.Start(options.ctx, fmt.Sprintf("HTTP %s", e.method),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.method", e.method),
+			attribute.String("http.url", e.urlFormat),
+		),
+	)
+	defer span.End()
+
+	c := &call{endpoint: e}
+	ctx = context.WithValue(ctx, callKey{}, c)
+
+	if options.timeout > 0 {
+		ctx = context.WithValue(ctx, timeoutKey{}, options.timeout)
+	}
+
+	if options.retryPolicy != nil {
+		ctx = context.WithValue(ctx, retryPolicyKey{}, *options.retryPolicy)
+	}
+
+	requestUrl, err := e.buildUrl(options.params)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", errBuildUrl, err)
+		return nil, recordError(span, WrapError(errBuildUrl, err))
 	}
 
 	var bodyReader io.Reader
@@ -75,9 +265,9 @@ func (e endpoint) Do(opts ...RequestOption) (*http.Response, error) {
 		bodyReader = bytes.NewBuffer(options.body)
 	}
 
-	req, err := http.NewRequest(e.method, url, bodyReader)
+	req, err := http.NewRequestWithContext(ctx, e.method, requestUrl, bodyReader)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", errHttpNewRequest, err)
+		return nil, recordError(span, WrapError(errHttpNewRequest, err))
 	}
 
 	q := req.URL.Query()
@@ -86,9 +276,55 @@ func (e endpoint) Do(opts ...RequestOption) (*http.Response, error) {
 	}
 	req.URL.RawQuery = q.Encode()
 
+	for key, values := range options.headers {
+		req.Header[key] = values
+	}
+
+	if isMutating(e.method) && req.Header.Get(HeaderIdempotencyKey) == "" {
+		req.Header.Set(HeaderIdempotencyKey, uuid.NewString())
+	}
+
+	if req.Header.Get(HeaderRequestID) == "" {
+		req.Header.Set(HeaderRequestID, RequestID(ctx))
+	}
+	requestID := req.Header.Get(HeaderRequestID)
+
+	e.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	if e.logger != nil {
+		e.logger.LogBody(ctx, "form3 request body", options.body, e.logArgs()...)
+	}
+
+	e.metrics.IncRequestsInFlight(e.name)
+	start := time.Now()
+
 	res, err := e.httpClient.Do(req)
+
+	duration := time.Since(start)
+	e.metrics.DecRequestsInFlight(e.name)
+
+	if options.stats != nil {
+		options.stats.RequestID = requestID
+		options.stats.Attempts = c.attempts
+		if c.attempts == 0 {
+			options.stats.Attempts = 1
+		}
+		options.stats.BytesSent = int64(len(options.body))
+	}
+
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", errDoRequest, err)
+		e.metrics.ObserveRequestDuration(e.name, metrics.StatusClassError, duration)
+		err = WrapError(errDoRequest, err)
+		e.logResponse(ctx, requestID, nil, duration, err)
+		return nil, recordError(span, err)
+	}
+
+	e.metrics.ObserveRequestDuration(e.name, metrics.StatusClass(res.StatusCode), duration)
+	e.logResponse(ctx, requestID, res, duration, nil)
+
+	span.SetAttributes(attribute.Int("http.status_code", res.StatusCode))
+	if res.StatusCode >= 500 {
+		span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
 	}
 
 	return res, nil
@@ -96,12 +332,112 @@ func (e endpoint) Do(opts ...RequestOption) (*http.Response, error) {
 
 func defaultRequestOptions() requestOptions {
 	return requestOptions{
+		ctx:         context.Background(),
 		queryParams: make(map[string]string),
 		params:      make(map[string]interface{}),
+		headers:     make(http.Header),
 		body:        nil,
 	}
 }
 
+// RequestID returns the request id of ctx, set with metadata.WithRequestID,
+// or a new one.
+func RequestID(ctx context.Context) string {
+	if requestID := metadata.RequestIDFromContext(ctx); requestID != "" {
+		return requestID
+	}
+
+	return uuid.NewString()
+}
+
+// isMutating tells the methods that get an idempotency key. A key is
+// generated once per call, so every retry of the call sends the same one.
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e endpoint) logArgs() []any {
+	return []any{
+		"endpoint", e.name,
+		"method", e.method,
+		"path", templatedPath(e.urlFormat),
+	}
+}
+
+func (e endpoint) logResponse(ctx context.Context, requestID string, res *http.Response, duration time.Duration, err error) {
+	if e.logger == nil {
+		return
+	}
+
+	args := append(e.logArgs(), "request_id", requestID, "duration", duration)
+
+	if err != nil {
+		e.logger.Log(ctx, e.logger.ErrorLevel(), "form3 request failed", append(args, "error", err)...)
+		return
+	}
+
+	args = append(args,
+		"status", res.StatusCode,
+		"server_request_id", res.Header.Get(HeaderRequestID),
+	)
+
+	level := e.logger.Level()
+	if res.StatusCode >= 400 {
+		level = e.logger.ErrorLevel()
+	}
+
+	e.logger.Log(ctx, level, "form3 request", args...)
+
+	if e.logger.Bodies() && res.Body != nil {
+		// one byte past the limit is read, the rest of a longer body is left
+		// to its reader, which fails as it would without logging
+		body, readErr := io.ReadAll(io.LimitReader(res.Body, MaxBodySize+1))
+
+		var rest io.Reader = res.Body
+		if readErr != nil {
+			rest = errorReader{readErr}
+		}
+		res.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), rest), Closer: res.Body}
+
+		if readErr == nil && len(body) <= MaxBodySize {
+			e.logger.LogBody(ctx, "form3 response body", body, args...)
+		}
+	}
+}
+
+type errorReader struct {
+	err error
+}
+
+func (r errorReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func templatedPath(urlFormat string) string {
+	u, err := url.Parse(urlFormat)
+	if err != nil {
+		return urlFormat
+	}
+
+	return u.Path
+}
+
+func recordError(span trace.Span, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	return err
+}
+
 func (e endpoint) buildUrl(params map[string]interface{}) (string, error) {
 	url := e.urlFormat
 
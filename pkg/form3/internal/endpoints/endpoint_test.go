@@ -1,12 +1,20 @@
 package endpoints
 
 import (
+	"context"
 	"errors"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/francorosatti/form3-api-client/pkg/form3/logging"
+	"github.com/francorosatti/form3-api-client/pkg/form3/metadata"
+	"github.com/google/uuid"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type mockHttpClient struct {
@@ -205,3 +213,172 @@ func Test_serialiseParamValue(t *testing.T) {
 		})
 	}
 }
+
+type nopLogger struct{}
+
+func (nopLogger) DebugContext(context.Context, string, ...any) {}
+func (nopLogger) InfoContext(context.Context, string, ...any)  {}
+func (nopLogger) WarnContext(context.Context, string, ...any)  {}
+func (nopLogger) ErrorContext(context.Context, string, ...any) {}
+
+func Test_endpoint_Do_logBodies(t *testing.T) {
+	// Arrange
+	client := &mockHttpClient{}
+	client.On("Do", mock.Anything).Return(&http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(strings.NewReader(`{"data":{}}`)),
+	}, nil)
+
+	e := NewEndpoint(client, "https://host/path", http.MethodGet,
+		WithLogger(logging.New(nopLogger{}, logging.WithBodies())))
+
+	// Act
+	got, err := e.Do()
+
+	// Assert
+	require.NoError(t, err)
+	body, err := io.ReadAll(got.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"data":{}}`, string(body))
+}
+
+type countingReader struct {
+	r    io.Reader
+	read int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.read += n
+	return n, err
+}
+
+func Test_endpoint_Do_logLargeBody(t *testing.T) {
+	// Arrange
+	reader := &countingReader{r: strings.NewReader(strings.Repeat("a", MaxBodySize+10))}
+	client := &mockHttpClient{}
+	client.On("Do", mock.Anything).Return(&http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(reader),
+	}, nil)
+
+	e := NewEndpoint(client, "https://host/path", http.MethodGet,
+		WithLogger(logging.New(nopLogger{}, logging.WithBodies())))
+
+	// Act
+	got, err := e.Do()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, MaxBodySize+1, reader.read)
+	body, err := io.ReadAll(got.Body)
+	assert.NoError(t, err)
+	assert.Len(t, body, MaxBodySize+10)
+}
+
+func Test_endpoint_Do_idempotencyKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		opts        []RequestOption
+		expectedKey func(t *testing.T, key string)
+	}{
+		{
+			name: "given a post request" +
+				"when doing request" +
+				"then send a generated idempotency key",
+			method: http.MethodPost,
+			expectedKey: func(t *testing.T, key string) {
+				_, err := uuid.Parse(key)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "given a delete request with an idempotency key" +
+				"when doing request" +
+				"then send the given key",
+			method: http.MethodDelete,
+			opts:   []RequestOption{WithIdempotencyKey("invoice-1")},
+			expectedKey: func(t *testing.T, key string) {
+				assert.Equal(t, "invoice-1", key)
+			},
+		},
+		{
+			name: "given a get request" +
+				"when doing request" +
+				"then do not send an idempotency key",
+			method: http.MethodGet,
+			expectedKey: func(t *testing.T, key string) {
+				assert.Empty(t, key)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			client := &mockHttpClient{}
+			client.On("Do", mock.Anything).Return(&http.Response{}, nil)
+
+			e := NewEndpoint(client, "https://host/path", tt.method)
+
+			// Act
+			_, err := e.Do(tt.opts...)
+
+			// Assert
+			require.NoError(t, err)
+			req := client.Calls[0].Arguments.Get(0).(*http.Request)
+			tt.expectedKey(t, req.Header.Get(HeaderIdempotencyKey))
+		})
+	}
+}
+
+func Test_endpoint_Do_requestID(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		opts       []RequestOption
+		expectedID string
+	}{
+		{
+			name: "given a context with request id" +
+				"when doing request" +
+				"then send the context request id",
+			ctx:        metadata.WithRequestID(context.Background(), "context_id"),
+			expectedID: "context_id",
+		},
+		{
+			name: "given a request id header" +
+				"when doing request" +
+				"then send the header request id",
+			ctx:        metadata.WithRequestID(context.Background(), "context_id"),
+			opts:       []RequestOption{WithHeader(HeaderRequestID, "header_id")},
+			expectedID: "header_id",
+		},
+		{
+			name: "given no request id" +
+				"when doing request" +
+				"then send a generated request id",
+			ctx: context.Background(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			client := &mockHttpClient{}
+			client.On("Do", mock.Anything).Return(&http.Response{}, nil)
+
+			e := NewEndpoint(client, "https://host/path", http.MethodGet)
+
+			// Act
+			_, err := e.Do(append([]RequestOption{WithContext(tt.ctx)}, tt.opts...)...)
+
+			// Assert
+			require.NoError(t, err)
+			requestID := client.Calls[0].Arguments.Get(0).(*http.Request).Header.Get(HeaderRequestID)
+			assert.NotEmpty(t, requestID)
+			if tt.expectedID != "" {
+				assert.Equal(t, tt.expectedID, requestID)
+			}
+		})
+	}
+}
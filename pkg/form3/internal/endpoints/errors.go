@@ -1,6 +1,9 @@
 package endpoints
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	errSerialiseParamValue  = errors.New("error serialising parameter value")
@@ -9,3 +12,27 @@ var (
 	errHttpNewRequest       = errors.New("error creating http request")
 	errDoRequest            = errors.New("error doing http request")
 )
+
+// WrapError wraps cause with a sentinel error. The result matches both with
+// errors.Is, and errors.As reaches the cause, e.g. a *url.Error or a
+// net.Error of a failed request.
+func WrapError(sentinel error, cause error) error {
+	return wrappedError{sentinel: sentinel, cause: cause}
+}
+
+type wrappedError struct {
+	sentinel error
+	cause    error
+}
+
+func (e wrappedError) Error() string {
+	return fmt.Sprintf("%s: %s", e.sentinel, e.cause)
+}
+
+func (e wrappedError) Is(target error) bool {
+	return errors.Is(e.sentinel, target)
+}
+
+func (e wrappedError) Unwrap() error {
+	return e.cause
+}
@@ -0,0 +1,54 @@
+package endpoints
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+type (
+	timeoutHttpClient struct {
+		next    IHttpClient
+		timeout time.Duration
+	}
+
+	cancelBody struct {
+		io.ReadCloser
+		cancel context.CancelFunc
+	}
+)
+
+// NewTimeoutHttpClient limits the time of every request, including reading
+// the response body, like http.Client.Timeout. Requests made with
+// WithTimeout replace the timeout. No timeout is zero.
+func NewTimeoutHttpClient(next IHttpClient, timeout time.Duration) IHttpClient {
+	return timeoutHttpClient{next: next, timeout: timeout}
+}
+
+func (c timeoutHttpClient) Do(req *http.Request) (*http.Response, error) {
+	timeout := c.timeout
+	if override, ok := TimeoutFromContext(req.Context()); ok {
+		timeout = override
+	}
+
+	if timeout <= 0 {
+		return c.next.Do(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+
+	res, err := c.next.Do(req.WithContext(ctx))
+	if err != nil || res.Body == nil {
+		cancel()
+		return res, err
+	}
+
+	res.Body = cancelBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+func (b cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
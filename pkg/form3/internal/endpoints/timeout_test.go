@@ -0,0 +1,75 @@
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_timeoutHttpClient_Do(t *testing.T) {
+	tests := []struct {
+		name        string
+		delay       time.Duration
+		timeout     time.Duration
+		opts        []RequestOption
+		expectedErr error
+	}{
+		{
+			name: "given a slow server" +
+				"when the client timeout expires" +
+				"then return deadline exceeded error",
+			delay:       50 * time.Millisecond,
+			timeout:     10 * time.Millisecond,
+			expectedErr: context.DeadlineExceeded,
+		},
+		{
+			name: "given a slow server and a longer request timeout" +
+				"when doing request" +
+				"then return the response",
+			delay:   20 * time.Millisecond,
+			timeout: 10 * time.Millisecond,
+			opts:    []RequestOption{WithTimeout(time.Second)},
+		},
+		{
+			name: "given a fast server and a shorter request timeout" +
+				"when the request timeout expires" +
+				"then return deadline exceeded error",
+			delay:       20 * time.Millisecond,
+			timeout:     time.Second,
+			opts:        []RequestOption{WithTimeout(time.Millisecond)},
+			expectedErr: context.DeadlineExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(tt.delay)
+				_, _ = w.Write([]byte("body"))
+			}))
+			defer server.Close()
+
+			e := NewEndpoint(NewTimeoutHttpClient(&http.Client{}, tt.timeout), server.URL, http.MethodGet)
+
+			// Act
+			res, err := e.Do(tt.opts...)
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				body, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+				assert.Equal(t, "body", string(body))
+				assert.NoError(t, res.Body.Close())
+			}
+		})
+	}
+}
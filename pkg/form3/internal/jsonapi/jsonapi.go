@@ -0,0 +1,228 @@
+package jsonapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/codec"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/metadata"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// MaxBodySize bounds the response bodies read from the api.
+	MaxBodySize = endpoints.MaxBodySize
+
+	// _maxDrainSize bounds the unread bytes discarded before closing a body,
+	// so that the connection can be reused. Longer bodies close it instead.
+	_maxDrainSize = 64 << 10
+
+	_attributeHttpStatusCode = attribute.Key("http.status_code")
+)
+
+var (
+	ErrBodyTooLarge = errors.New("response body too large")
+)
+
+type (
+	// Errors holds the sentinel errors of a client. The failures of its calls
+	// wrap them, so that every client keeps its own error values.
+	Errors struct {
+		DoRequest  error
+		ReadBody   error
+		Unmarshal  error
+		StatusCode error
+		BadRequest error
+		NotFound   error
+		Conflict   error
+	}
+
+	// Executor does the requests of a client. Codec encodes and decodes the
+	// documents, a lenient codec.JSON when nil. Metadata, when set, is filled
+	// with the metadata of the call.
+	Executor struct {
+		Errors   Errors
+		Codec    codec.ICodec
+		Metadata *metadata.Call
+	}
+)
+
+// Call does a request and decodes the response document into T straight
+// from the response body. Successful responses without body, like 204,
+// return the zero T.
+func Call[T any](ctx context.Context, executor Executor, endpoint endpoints.IEndpoint, opts ...endpoints.RequestOption) (T, error) {
+	var document T
+
+	err := executor.do(ctx, endpoint, opts, func(body *bodyReader) error {
+		return executor.decode(body, &document)
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return document, nil
+}
+
+// Do does a request whose response document is not needed.
+func (e Executor) Do(ctx context.Context, endpoint endpoints.IEndpoint, opts ...endpoints.RequestOption) error {
+	return e.do(ctx, endpoint, opts, nil)
+}
+
+// Encode encodes a request document.
+func (e Executor) Encode(document interface{}) ([]byte, error) {
+	return e.codec().Marshal(document)
+}
+
+// do sends the same request id on every attempt of a call, unless opts set
+// another one, which the call metadata reports then. Its errors carry the
+// call metadata in a *metadata.Error.
+func (e Executor) do(ctx context.Context, endpoint endpoints.IEndpoint, opts []endpoints.RequestOption, decode func(*bodyReader) error) (err error) {
+	call := metadata.Call{RequestID: endpoints.RequestID(ctx)}
+	stats := endpoints.Stats{}
+	start := time.Now()
+	defer func() {
+		call.Duration = time.Since(start)
+		if stats.RequestID != "" {
+			call.RequestID = stats.RequestID
+		}
+		call.Attempts = stats.Attempts
+		call.BytesSent = stats.BytesSent
+
+		if e.Metadata != nil {
+			*e.Metadata = call
+		}
+
+		if err != nil {
+			err = &metadata.Error{Call: call, Err: err}
+		}
+	}()
+
+	res, err := endpoint.Do(append([]endpoints.RequestOption{
+		endpoints.WithContext(ctx),
+		endpoints.WithHeader(endpoints.HeaderRequestID, call.RequestID),
+		endpoints.WithStats(&stats),
+	}, opts...)...)
+	if err != nil {
+		return endpoints.WrapError(e.Errors.DoRequest, err)
+	}
+
+	body := newBodyReader(res.Body)
+	defer func() {
+		closeBody(body, res.Body)
+		call.BytesReceived = body.read
+	}()
+
+	call.ServerRequestID = res.Header.Get(endpoints.HeaderRequestID)
+	call.StatusCode = res.StatusCode
+	call.Header = res.Header
+
+	trace.SpanFromContext(ctx).SetAttributes(_attributeHttpStatusCode.Int(res.StatusCode))
+
+	if res.StatusCode >= 300 {
+		content, err := ioutil.ReadAll(body)
+		if err != nil {
+			return endpoints.WrapError(e.Errors.ReadBody, err)
+		}
+
+		return e.Errors.Status(res.StatusCode, content)
+	}
+
+	if decode == nil {
+		return nil
+	}
+
+	return decode(body)
+}
+
+func (e Executor) decode(body *bodyReader, document interface{}) error {
+	err := e.codec().Decode(body, document)
+
+	switch {
+	case err == nil:
+		return nil
+	case body.err != nil:
+		return endpoints.WrapError(e.Errors.ReadBody, body.err)
+	case body.read == 0:
+		// no content
+		return nil
+	default:
+		return endpoints.WrapError(e.Errors.Unmarshal, err)
+	}
+}
+
+func (e Executor) codec() codec.ICodec {
+	if e.Codec == nil {
+		return codec.JSON{}
+	}
+
+	return e.Codec
+}
+
+// Status returns the error a response status code maps to, nil when the
+// status code is successful. Bad requests carry the api error message.
+func (errs Errors) Status(statusCode int, body []byte) error {
+	if statusCode < 300 {
+		return nil
+	}
+
+	switch statusCode {
+	case 400:
+		return fmt.Errorf("%w: %s", errs.BadRequest, errorMessage(body))
+	case 404:
+		return errs.NotFound
+	case 409:
+		return errs.Conflict
+	default:
+		return fmt.Errorf("%w: status code %d", errs.StatusCode, statusCode)
+	}
+}
+
+func errorMessage(body []byte) string {
+	document := models.ErrorDocument{}
+	if err := json.Unmarshal(body, &document); err != nil || document.ErrorMessage == "" {
+		return string(body)
+	}
+
+	return document.ErrorMessage
+}
+
+// bodyReader reads up to MaxBodySize bytes of a response body. It keeps the
+// first read error to tell it apart from the decode errors.
+type bodyReader struct {
+	r    io.Reader
+	read int64
+	err  error
+}
+
+func newBodyReader(body io.Reader) *bodyReader {
+	return &bodyReader{r: io.LimitReader(body, MaxBodySize+1)}
+}
+
+func (b *bodyReader) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	b.read += int64(n)
+
+	if b.read > MaxBodySize {
+		err = ErrBodyTooLarge
+	}
+
+	if err != nil && err != io.EOF && b.err == nil {
+		b.err = err
+	}
+
+	return n, err
+}
+
+func closeBody(body *bodyReader, closer io.Closer) {
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(body, _maxDrainSize))
+	_ = closer.Close()
+}
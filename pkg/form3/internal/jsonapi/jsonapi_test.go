@@ -0,0 +1,392 @@
+package jsonapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/codec"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/metadata"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	errDoRequest  = errors.New("do request")
+	errReadBody   = errors.New("read body")
+	errUnmarshal  = errors.New("unmarshal")
+	errStatusCode = errors.New("status code")
+	errBadRequest = errors.New("bad request")
+	errNotFound   = errors.New("not found")
+	errConflict   = errors.New("conflict")
+
+	_errors = Errors{
+		DoRequest:  errDoRequest,
+		ReadBody:   errReadBody,
+		Unmarshal:  errUnmarshal,
+		StatusCode: errStatusCode,
+		BadRequest: errBadRequest,
+		NotFound:   errNotFound,
+		Conflict:   errConflict,
+	}
+)
+
+type endpointMock struct {
+	mock.Mock
+}
+
+func (m *endpointMock) Do(opts ...endpoints.RequestOption) (*http.Response, error) {
+	called := m.Called(opts)
+	return called.Get(0).(*http.Response), called.Error(1)
+}
+
+func TestCall(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		body        string
+		doErr       error
+		expectedOut models.Account
+		expectedErr error
+	}{
+		{
+			name: "given any input" +
+				"when endpoint request fails" +
+				"then return do request error",
+			doErr:       errors.New("mock_error"),
+			expectedErr: errDoRequest,
+		},
+		{
+			name: "given any input" +
+				"when endpoint responds status bad request" +
+				"then return bad request error",
+			statusCode:  400,
+			expectedErr: errBadRequest,
+		},
+		{
+			name: "given any input" +
+				"when endpoint responds status not found" +
+				"then return not found error",
+			statusCode:  404,
+			expectedErr: errNotFound,
+		},
+		{
+			name: "given any input" +
+				"when endpoint responds status conflict" +
+				"then return conflict error",
+			statusCode:  409,
+			expectedErr: errConflict,
+		},
+		{
+			name: "given any input" +
+				"when endpoint responds status internal server error" +
+				"then return status code error",
+			statusCode:  500,
+			expectedErr: errStatusCode,
+		},
+		{
+			name: "given any input" +
+				"when endpoint responds invalid json" +
+				"then return unmarshal error",
+			statusCode:  200,
+			body:        "}",
+			expectedErr: errUnmarshal,
+		},
+		{
+			name: "given any input" +
+				"when endpoint responds a body larger than the maximum" +
+				"then return read body error",
+			statusCode:  200,
+			body:        `"` + strings.Repeat("a", MaxBodySize) + `"`,
+			expectedErr: ErrBodyTooLarge,
+		},
+		{
+			name: "given any input" +
+				"when endpoint responds status ok" +
+				"then return the decoded document",
+			statusCode: 200,
+			body:       `{"data":{"id":"id","attributes":{"bank_id":"bank_id"}}}`,
+			expectedOut: *new(models.Account).WithData(
+				*new(models.AccountData).WithID("id").WithAttributes(models.AccountAttributes{BankID: "bank_id"}),
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			endpoint := &endpointMock{}
+			endpoint.On("Do", mock.Anything).Return(&http.Response{
+				StatusCode: tt.statusCode,
+				Body:       io.NopCloser(strings.NewReader(tt.body)),
+			}, tt.doErr)
+
+			// Act
+			got, err := Call[models.Account](context.Background(), Executor{Errors: _errors}, endpoint)
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+			assert.Equal(t, tt.expectedOut, got)
+		})
+	}
+}
+
+func TestCall_codec(t *testing.T) {
+	body := `{"data":{"id":"id","unknown":"value"}}`
+
+	tests := []struct {
+		name        string
+		codec       codec.ICodec
+		expectedOut models.Account
+		expectedErr error
+	}{
+		{
+			name: "given the default codec" +
+				"when the response has unknown fields" +
+				"then ignore them",
+			expectedOut: *new(models.Account).WithData(*new(models.AccountData).WithID("id")),
+		},
+		{
+			name: "given a strict codec" +
+				"when the response has unknown fields" +
+				"then return unmarshal error",
+			codec:       codec.JSON{DisallowUnknownFields: true},
+			expectedErr: errUnmarshal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			endpoint := &endpointMock{}
+			endpoint.On("Do", mock.Anything).Return(&http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil)
+
+			// Act
+			got, err := Call[models.Account](context.Background(), Executor{Errors: _errors, Codec: tt.codec}, endpoint)
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+			assert.Equal(t, tt.expectedOut, got)
+		})
+	}
+}
+
+func TestCall_streamsBody(t *testing.T) {
+	// Arrange
+	reader, writer := io.Pipe()
+	go func() {
+		_, _ = writer.Write([]byte(`{"data":{"id":"id"}}`))
+		// the document is decoded before the body ends
+		_, _ = writer.Write([]byte(strings.Repeat(" ", MaxBodySize)))
+		_ = writer.Close()
+	}()
+
+	endpoint := &endpointMock{}
+	endpoint.On("Do", mock.Anything).Return(&http.Response{StatusCode: 200, Body: reader}, nil)
+
+	// Act
+	got, err := Call[models.Account](context.Background(), Executor{Errors: _errors}, endpoint)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "id", got.Data.ID)
+}
+
+func TestDocument_json(t *testing.T) {
+	// Arrange
+	model := *new(models.Account).WithData(
+		*new(models.AccountData).WithID("id").WithAttributes(models.AccountAttributes{BankID: "bank_id"}),
+	)
+
+	// Act
+	got, err := json.Marshal(model)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, `{"data":{"attributes":{"bank_id":"bank_id"},"id":"id"}}`, string(got))
+}
+
+type trackingBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *trackingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestDo_body(t *testing.T) {
+	tests := []struct {
+		name            string
+		statusCode      int
+		body            string
+		expectedErr     error
+		expectedMessage string
+	}{
+		{
+			name: "given an error body larger than the maximum" +
+				"when doing the request" +
+				"then return body too large error",
+			statusCode:  500,
+			body:        strings.Repeat("a", MaxBodySize+1),
+			expectedErr: ErrBodyTooLarge,
+		},
+		{
+			name: "given an error document" +
+				"when endpoint responds status bad request" +
+				"then return the api error message",
+			statusCode:      400,
+			body:            `{"error_message":"id in body is required"}`,
+			expectedErr:     errBadRequest,
+			expectedMessage: "bad request: id in body is required",
+		},
+		{
+			name: "given a body that is not an error document" +
+				"when endpoint responds status bad request" +
+				"then return the raw body",
+			statusCode:      400,
+			body:            "invalid",
+			expectedErr:     errBadRequest,
+			expectedMessage: "bad request: invalid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			body := &trackingBody{Reader: strings.NewReader(tt.body)}
+			endpoint := &endpointMock{}
+			endpoint.On("Do", mock.Anything).Return(&http.Response{StatusCode: tt.statusCode, Body: body}, nil)
+
+			// Act
+			err := Executor{Errors: _errors}.Do(context.Background(), endpoint)
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+			assert.True(t, body.closed)
+			if tt.expectedMessage != "" {
+				assert.EqualError(t, err, tt.expectedMessage)
+			}
+		})
+	}
+}
+
+func TestCall_noContent(t *testing.T) {
+	// Arrange
+	endpoint := &endpointMock{}
+	endpoint.On("Do", mock.Anything).Return(&http.Response{StatusCode: 204, Body: io.NopCloser(strings.NewReader(""))}, nil)
+
+	// Act
+	got, err := Call[models.Account](context.Background(), Executor{Errors: _errors}, endpoint)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, models.Account{}, got)
+}
+
+func TestDo_keepsCause(t *testing.T) {
+	// Arrange
+	cause := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	endpoint := &endpointMock{}
+	endpoint.On("Do", mock.Anything).Return((*http.Response)(nil), &url.Error{Op: "Post", URL: "url", Err: cause})
+
+	// Act
+	err := Executor{Errors: _errors}.Do(context.Background(), endpoint)
+
+	// Assert
+	var opErr *net.OpError
+	assert.True(t, errors.Is(err, errDoRequest))
+	assert.True(t, errors.As(err, &opErr))
+	assert.Equal(t, cause, opErr)
+}
+
+func TestDo_metadata(t *testing.T) {
+	// Arrange
+	var requestIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestIDs = append(requestIDs, r.Header.Get(endpoints.HeaderRequestID))
+		w.Header().Set(endpoints.HeaderRequestID, "server_request_id")
+		w.Header().Set("X-Rate-Limit-Remaining", "9")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("not found"))
+	}))
+	defer server.Close()
+
+	retryingClient := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		res, err := endpoints.DoAttempt(http.DefaultClient, req)
+		if err != nil {
+			return nil, err
+		}
+		closeBody(newBodyReader(res.Body), res.Body)
+		req.Body, _ = req.GetBody()
+
+		return endpoints.DoAttempt(http.DefaultClient, req)
+	})
+
+	endpoint := endpoints.NewEndpoint(retryingClient, server.URL, http.MethodGet)
+	ctx := metadata.WithRequestID(context.Background(), "request_id")
+
+	var meta metadata.Call
+
+	// Act
+	err := Executor{Errors: _errors, Metadata: &meta}.Do(ctx, endpoint, endpoints.WithBody([]byte("body")))
+
+	// Assert
+	var callErr *metadata.Error
+	assert.True(t, errors.Is(err, errNotFound))
+	assert.True(t, errors.As(err, &callErr))
+	assert.Equal(t, meta, callErr.Call)
+	assert.Equal(t, []string{"request_id", "request_id"}, requestIDs)
+	assert.Equal(t, "request_id", meta.RequestID)
+	assert.Equal(t, "server_request_id", meta.ServerRequestID)
+	assert.Equal(t, http.StatusNotFound, meta.StatusCode)
+	assert.Equal(t, "9", meta.Header.Get("X-Rate-Limit-Remaining"))
+	assert.Equal(t, 2, meta.Attempts)
+	assert.Equal(t, int64(4), meta.BytesSent)
+	assert.Equal(t, int64(9), meta.BytesReceived)
+	assert.Positive(t, meta.Duration)
+}
+
+func TestDo_metadataRequestIDOverride(t *testing.T) {
+	// Arrange
+	var requestID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID = r.Header.Get(endpoints.HeaderRequestID)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	endpoint := endpoints.NewEndpoint(http.DefaultClient, server.URL, http.MethodGet)
+	ctx := metadata.WithRequestID(context.Background(), "request_id")
+
+	var meta metadata.Call
+
+	// Act
+	err := Executor{Errors: _errors, Metadata: &meta}.Do(ctx, endpoint, endpoints.WithHeader(endpoints.HeaderRequestID, "override"))
+
+	// Assert
+	var callErr *metadata.Error
+	assert.True(t, errors.As(err, &callErr))
+	assert.Equal(t, "override", requestID)
+	assert.Equal(t, "override", meta.RequestID)
+	assert.Equal(t, "override", callErr.Call.RequestID)
+}
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
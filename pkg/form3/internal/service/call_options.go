@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/metadata"
+	"github.com/francorosatti/form3-api-client/pkg/form3/retry"
+)
+
+const (
+	_headerCacheControl = "Cache-Control"
+)
+
+type (
+	CallOption func(*CallOptions)
+
+	// CallOptions are the options of a single call of any resource client.
+	CallOptions struct {
+		ctx            context.Context
+		idempotencyKey string
+		metadata       *metadata.Call
+		timeout        time.Duration
+		retryPolicy    *retry.Policy
+		headers        http.Header
+	}
+)
+
+func WithContext(ctx context.Context) CallOption {
+	return func(options *CallOptions) {
+		options.ctx = ctx
+	}
+}
+
+// WithIdempotencyKey replaces the idempotency key generated for a mutating
+// call, e.g. with a business identifier, so that calls repeated after a
+// timeout are recognised by the api too.
+func WithIdempotencyKey(key string) CallOption {
+	return func(options *CallOptions) {
+		options.idempotencyKey = key
+	}
+}
+
+// WithMetadata fills meta with the metadata of the call, like its request
+// ids, response status and headers, duration and attempts, when the call
+// reaches the api.
+func WithMetadata(meta *metadata.Call) CallOption {
+	return func(options *CallOptions) {
+		options.metadata = meta
+	}
+}
+
+// WithTimeout replaces the client timeout of every attempt of the call,
+// e.g. a short one for api handlers or a long one for batch jobs.
+func WithTimeout(timeout time.Duration) CallOption {
+	return func(options *CallOptions) {
+		options.timeout = timeout
+	}
+}
+
+// WithRetryPolicy replaces the client retry policy for the call. It needs a
+// client created with form3.NewClient, which retries requests.
+func WithRetryPolicy(policy retry.Policy) CallOption {
+	return func(options *CallOptions) {
+		options.retryPolicy = &policy
+	}
+}
+
+// WithHeader adds a header to the requests of the call.
+func WithHeader(key, value string) CallOption {
+	return func(options *CallOptions) {
+		options.headers.Set(key, value)
+	}
+}
+
+// WithCacheBypass asks the caches between the client and the api, like
+// proxies, for a fresh response. The client itself does not cache responses.
+func WithCacheBypass() CallOption {
+	return WithHeader(_headerCacheControl, "no-cache")
+}
+
+func NewCallOptions(opts []CallOption) CallOptions {
+	options := CallOptions{
+		ctx:     context.Background(),
+		headers: make(http.Header),
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+func (o CallOptions) Context() context.Context {
+	return o.ctx
+}
+
+// RequestOptions adds the request options of the call to opts.
+func (o CallOptions) RequestOptions(opts ...endpoints.RequestOption) []endpoints.RequestOption {
+	for key := range o.headers {
+		opts = append(opts, endpoints.WithHeader(key, o.headers.Get(key)))
+	}
+
+	if o.idempotencyKey != "" {
+		opts = append(opts, endpoints.WithIdempotencyKey(o.idempotencyKey))
+	}
+
+	if o.timeout > 0 {
+		opts = append(opts, endpoints.WithTimeout(o.timeout))
+	}
+
+	if o.retryPolicy != nil {
+		opts = append(opts, endpoints.WithRetryPolicy(*o.retryPolicy))
+	}
+
+	return opts
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/jsonapi"
+)
+
+var (
+	ErrDoRequest          = errors.New("error doing request")
+	ErrResponseReadBody   = errors.New("error reading response body")
+	ErrResponseStatusCode = errors.New("response error")
+	ErrResponseUnmarshal  = errors.New("error unmarshalling response")
+)
+
+// Errors holds the sentinel errors of a client. The transport and response
+// errors are shared by every client.
+type Errors struct {
+	InvalidParameters error
+	BadRequest        error
+	NotFound          error
+	Conflict          error
+}
+
+func (errs Errors) jsonapi() jsonapi.Errors {
+	return jsonapi.Errors{
+		DoRequest:  ErrDoRequest,
+		ReadBody:   ErrResponseReadBody,
+		Unmarshal:  ErrResponseUnmarshal,
+		StatusCode: ErrResponseStatusCode,
+		BadRequest: errs.BadRequest,
+		NotFound:   errs.NotFound,
+		Conflict:   errs.Conflict,
+	}
+}
+
+// class groups the errors of the client operations for span attributes and
+// metrics.
+func (errs Errors) class(err error) string {
+	switch {
+	case errs.InvalidParameters != nil && errors.Is(err, errs.InvalidParameters):
+		return "invalid_parameters"
+	case errs.BadRequest != nil && errors.Is(err, errs.BadRequest):
+		return "bad_request"
+	case errs.NotFound != nil && errors.Is(err, errs.NotFound):
+		return "not_found"
+	case errs.Conflict != nil && errors.Is(err, errs.Conflict):
+		return "conflict"
+	case errors.Is(err, ErrDoRequest):
+		return "transport"
+	case errors.Is(err, ErrResponseReadBody), errors.Is(err, ErrResponseUnmarshal):
+		return "response"
+	case errors.Is(err, ErrResponseStatusCode):
+		return "server"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrors_class(t *testing.T) {
+	errConflict := errors.New("conflict")
+
+	tests := []struct {
+		name        string
+		errs        Errors
+		err         error
+		expectedOut string
+	}{
+		{
+			name: "given a conflict error" +
+				"when classifying error" +
+				"then return conflict",
+			errs:        Errors{Conflict: errConflict},
+			err:         fmt.Errorf("%w: version mismatch", errConflict),
+			expectedOut: "conflict",
+		},
+		{
+			name: "given a request error" +
+				"when classifying error" +
+				"then return transport",
+			errs:        Errors{Conflict: errConflict},
+			err:         ErrDoRequest,
+			expectedOut: "transport",
+		},
+		{
+			name: "given an unknown error and no client sentinels" +
+				"when classifying error" +
+				"then return unknown",
+			errs:        Errors{},
+			err:         io.EOF,
+			expectedOut: "unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := tt.errs.class(tt.err)
+
+			// Assert
+			assert.Equal(t, tt.expectedOut, got)
+		})
+	}
+}
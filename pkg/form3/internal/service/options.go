@@ -0,0 +1,73 @@
+package service
+
+import (
+	"github.com/francorosatti/form3-api-client/pkg/form3/codec"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/logging"
+	"github.com/francorosatti/form3-api-client/pkg/form3/metrics"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	Option func(*Options)
+
+	// Options are the options of every resource client.
+	Options struct {
+		HttpClient     endpoints.IHttpClient
+		TracerProvider trace.TracerProvider
+		Propagator     propagation.TextMapPropagator
+		Metrics        metrics.ICollector
+		Logger         *logging.Logger
+		Codec          codec.ICodec
+		OrganisationID string
+	}
+)
+
+// WithOrganisationID sets the organisation of created resources that do not
+// have one.
+func WithOrganisationID(organisationID string) Option {
+	return func(options *Options) {
+		options.OrganisationID = organisationID
+	}
+}
+
+// WithCodec replaces the lenient encoding/json codec of the documents.
+func WithCodec(codec codec.ICodec) Option {
+	return func(options *Options) {
+		options.Codec = codec
+	}
+}
+
+func WithHttpClient(httpClient endpoints.IHttpClient) Option {
+	return func(options *Options) {
+		options.HttpClient = httpClient
+	}
+}
+
+// WithTracerProvider enables tracing of the client operations. Outgoing
+// requests carry a W3C traceparent header unless another propagator is
+// configured.
+func WithTracerProvider(tracerProvider trace.TracerProvider) Option {
+	return func(options *Options) {
+		options.TracerProvider = tracerProvider
+	}
+}
+
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(options *Options) {
+		options.Propagator = propagator
+	}
+}
+
+func WithMetrics(collector metrics.ICollector) Option {
+	return func(options *Options) {
+		options.Metrics = collector
+	}
+}
+
+func WithLogger(logger *logging.Logger) Option {
+	return func(options *Options) {
+		options.Logger = logger
+	}
+}
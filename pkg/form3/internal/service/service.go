@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/jsonapi"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	_defaultTimeout = 3 * time.Second
+
+	AttributeOrganisationID = attribute.Key("form3.organisation.id")
+	AttributeErrorClass     = attribute.Key("form3.error.class")
+)
+
+// Service is what the resource clients share. It creates their endpoints,
+// traces their operations and counts their errors.
+type Service struct {
+	options Options
+	errors  Errors
+	tracer  trace.Tracer
+}
+
+// New creates the service of a client, whose spans are created by the
+// tracer tracerName.
+func New(tracerName string, errs Errors, opts []Option) Service {
+	options := Options{}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.HttpClient == nil {
+		options.HttpClient = endpoints.NewTimeoutHttpClient(&http.Client{}, _defaultTimeout)
+	}
+
+	service := Service{
+		options: options,
+		errors:  errs,
+		tracer:  trace.NewNoopTracerProvider().Tracer(tracerName),
+	}
+
+	if options.TracerProvider != nil {
+		service.tracer = options.TracerProvider.Tracer(tracerName)
+	}
+
+	return service
+}
+
+// OrganisationID is the organisation of created resources that do not have
+// one, empty when not set.
+func (s Service) OrganisationID() string {
+	return s.options.OrganisationID
+}
+
+func (s Service) Endpoint(name string, url string, method string) endpoints.IEndpoint {
+	opts := []endpoints.Option{endpoints.WithName(name)}
+
+	if s.options.Metrics != nil {
+		opts = append(opts, endpoints.WithMetrics(s.options.Metrics))
+	}
+
+	if s.options.Logger != nil {
+		opts = append(opts, endpoints.WithLogger(s.options.Logger))
+	}
+
+	if s.options.TracerProvider != nil {
+		propagator := s.options.Propagator
+		if propagator == nil {
+			propagator = propagation.TraceContext{}
+		}
+
+		opts = append(opts, endpoints.WithTracer(s.tracer), endpoints.WithPropagator(propagator))
+	}
+
+	return endpoints.NewEndpoint(s.options.HttpClient, url, method, opts...)
+}
+
+// Executor returns the executor of a call.
+func (s Service) Executor(options CallOptions) jsonapi.Executor {
+	return jsonapi.Executor{Errors: s.errors.jsonapi(), Codec: s.options.Codec, Metadata: options.metadata}
+}
+
+// Start starts the span of an operation. It is a no-op unless the client
+// has a tracer provider.
+func (s Service) Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	tracer := s.tracer
+	if tracer == nil {
+		tracer = trace.NewNoopTracerProvider().Tracer("")
+	}
+
+	return tracer.Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End ends the span of an operation of endpoint and counts its error.
+func (s Service) End(span trace.Span, endpoint string, err error) {
+	if err != nil {
+		class := s.errors.class(err)
+
+		span.RecordError(err)
+		span.SetAttributes(AttributeErrorClass.String(class))
+		span.SetStatus(codes.Error, err.Error())
+
+		if s.options.Metrics != nil {
+			s.options.Metrics.IncErrors(endpoint, class)
+		}
+	}
+
+	span.End()
+}
@@ -0,0 +1,101 @@
+package logging
+
+import "context"
+
+const (
+	LevelDebug Level = -4
+	LevelInfo  Level = 0
+	LevelWarn  Level = 4
+	LevelError Level = 8
+)
+
+type (
+	// ILogger is satisfied by *slog.Logger.
+	ILogger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+		InfoContext(ctx context.Context, msg string, args ...any)
+		WarnContext(ctx context.Context, msg string, args ...any)
+		ErrorContext(ctx context.Context, msg string, args ...any)
+	}
+
+	// Level values match slog levels.
+	Level int
+
+	Option func(*Logger)
+
+	Logger struct {
+		logger     ILogger
+		level      Level
+		errorLevel Level
+		bodies     bool
+	}
+)
+
+func New(logger ILogger, opts ...Option) *Logger {
+	l := &Logger{
+		logger:     logger,
+		level:      LevelDebug,
+		errorLevel: LevelError,
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
+}
+
+// WithLevel sets the level used to log successful requests.
+func WithLevel(level Level) Option {
+	return func(l *Logger) {
+		l.level = level
+	}
+}
+
+// WithErrorLevel sets the level used to log failed requests.
+func WithErrorLevel(level Level) Option {
+	return func(l *Logger) {
+		l.errorLevel = level
+	}
+}
+
+// WithBodies enables debug logs of request and response bodies, with
+// sensitive fields masked.
+func WithBodies() Option {
+	return func(l *Logger) {
+		l.bodies = true
+	}
+}
+
+func (l *Logger) Level() Level {
+	return l.level
+}
+
+func (l *Logger) ErrorLevel() Level {
+	return l.errorLevel
+}
+
+func (l *Logger) Bodies() bool {
+	return l.bodies
+}
+
+func (l *Logger) Log(ctx context.Context, level Level, msg string, args ...any) {
+	switch {
+	case level < LevelInfo:
+		l.logger.DebugContext(ctx, msg, args...)
+	case level < LevelWarn:
+		l.logger.InfoContext(ctx, msg, args...)
+	case level < LevelError:
+		l.logger.WarnContext(ctx, msg, args...)
+	default:
+		l.logger.ErrorContext(ctx, msg, args...)
+	}
+}
+
+func (l *Logger) LogBody(ctx context.Context, msg string, body []byte, args ...any) {
+	if !l.bodies {
+		return
+	}
+
+	l.logger.DebugContext(ctx, msg, append(args, "body", string(MaskJSON(body)))...)
+}
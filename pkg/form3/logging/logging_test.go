@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingLogger struct {
+	levels []string
+}
+
+func (l *recordingLogger) DebugContext(context.Context, string, ...any) {
+	l.levels = append(l.levels, "debug")
+}
+
+func (l *recordingLogger) InfoContext(context.Context, string, ...any) {
+	l.levels = append(l.levels, "info")
+}
+
+func (l *recordingLogger) WarnContext(context.Context, string, ...any) {
+	l.levels = append(l.levels, "warn")
+}
+
+func (l *recordingLogger) ErrorContext(context.Context, string, ...any) {
+	l.levels = append(l.levels, "error")
+}
+
+func TestLogger_Log(t *testing.T) {
+	// Arrange
+	recorder := &recordingLogger{}
+	logger := New(recorder, WithLevel(LevelInfo), WithErrorLevel(LevelWarn))
+
+	// Act
+	logger.Log(context.Background(), logger.Level(), "request")
+	logger.Log(context.Background(), logger.ErrorLevel(), "request failed")
+	logger.Log(context.Background(), LevelDebug, "debug")
+	logger.Log(context.Background(), LevelError, "error")
+
+	// Assert
+	assert.Equal(t, []string{"info", "warn", "debug", "error"}, recorder.levels)
+}
+
+func TestLogger_LogBody(t *testing.T) {
+	tests := []struct {
+		name           string
+		opts           []Option
+		expectedLevels []string
+	}{
+		{
+			name: "given a logger without bodies" +
+				"when logging body" +
+				"then do not log",
+			opts:           nil,
+			expectedLevels: nil,
+		},
+		{
+			name: "given a logger with bodies" +
+				"when logging body" +
+				"then log at debug level",
+			opts:           []Option{WithBodies()},
+			expectedLevels: []string{"debug"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			recorder := &recordingLogger{}
+			logger := New(recorder, tt.opts...)
+
+			// Act
+			logger.LogBody(context.Background(), "body", []byte("{}"))
+
+			// Assert
+			assert.Equal(t, tt.expectedLevels, recorder.levels)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package logging
+
+import "encoding/json"
+
+const (
+	Redacted = "[REDACTED]"
+
+	_redactedBody = "[REDACTED NON-JSON BODY]"
+)
+
+var (
+	_sensitiveFields = map[string]struct{}{
+		"account_name":             {},
+		"account_number":           {},
+		"alternative_names":        {},
+		"iban":                     {},
+		"name":                     {},
+		"secondary_identification": {},
+	}
+)
+
+// IsSensitive reports whether an account or payment attribute is masked by
+// MaskJSON.
+func IsSensitive(field string) bool {
+	_, sensitive := _sensitiveFields[field]
+	return sensitive
+}
+
+// MaskJSON replaces the values of sensitive account attributes in a json
+// document. Bodies that are not valid json are masked completely.
+func MaskJSON(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	var document interface{}
+	if err := json.Unmarshal(body, &document); err != nil {
+		return []byte(_redactedBody)
+	}
+
+	masked, err := json.Marshal(mask(document))
+	if err != nil {
+		return []byte(_redactedBody)
+	}
+
+	return masked
+}
+
+func mask(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if IsSensitive(key) {
+				v[key] = Redacted
+				continue
+			}
+			v[key] = mask(field)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = mask(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,59 @@
+package logging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        []byte
+		expectedOut string
+	}{
+		{
+			name: "given an account with sensitive attributes" +
+				"when masking json" +
+				"then return json with masked attributes",
+			body: []byte(`{"data":{"id":"id","attributes":{"bank_id":"123456","account_number":"12345678",` +
+				`"iban":"GB11NWBK","name":["name"],"alternative_names":["alt"],"secondary_identification":"sec"}}}`),
+			expectedOut: `{"data":{"attributes":{"account_number":"[REDACTED]","alternative_names":"[REDACTED]",` +
+				`"bank_id":"123456","iban":"[REDACTED]","name":"[REDACTED]","secondary_identification":"[REDACTED]"},"id":"id"}}`,
+		},
+		{
+			name: "given a payment with sensitive party attributes" +
+				"when masking json" +
+				"then return json with masked party attributes",
+			body: []byte(`{"data":{"id":"id","attributes":{"amount":"10.00","currency":"GBP",` +
+				`"debtor_party":{"account_name":"debtor","account_number":"12345678","bank_id":"123456"},` +
+				`"beneficiary_party":{"account_name":"beneficiary","account_number":"87654321","bank_id":"654321"}}}}`),
+			expectedOut: `{"data":{"attributes":{"amount":"10.00",` +
+				`"beneficiary_party":{"account_name":"[REDACTED]","account_number":"[REDACTED]","bank_id":"654321"},` +
+				`"currency":"GBP","debtor_party":{"account_name":"[REDACTED]","account_number":"[REDACTED]","bank_id":"123456"}},"id":"id"}}`,
+		},
+		{
+			name: "given an empty body" +
+				"when masking json" +
+				"then return empty body",
+			body:        []byte{},
+			expectedOut: "",
+		},
+		{
+			name: "given a non json body" +
+				"when masking json" +
+				"then return masked body",
+			body:        []byte("account 12345678"),
+			expectedOut: _redactedBody,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := MaskJSON(tt.body)
+
+			// Assert
+			assert.Equal(t, tt.expectedOut, string(got))
+		})
+	}
+}
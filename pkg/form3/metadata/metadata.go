@@ -0,0 +1,61 @@
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+type (
+	// Call describes a client call. It is filled by the WithMetadata call
+	// option of every client, also when the call fails.
+	Call struct {
+		// RequestID is the X-Request-Id sent on every attempt of the call.
+		RequestID string
+		// ServerRequestID is the X-Request-Id of the api response, empty when
+		// there is no response.
+		ServerRequestID string
+		// StatusCode and Header are the ones of the api response, e.g. to read
+		// rate limit or deprecation headers. They are zero when there is no
+		// response.
+		StatusCode int
+		Header     http.Header
+		// Duration is the time of the whole call, retries and response body
+		// included.
+		Duration time.Duration
+		// Attempts counts the requests sent, retries included.
+		Attempts int
+		// BytesSent is the size of the request body and BytesReceived the
+		// bytes of the response body read by the client.
+		BytesSent     int64
+		BytesReceived int64
+	}
+
+	// Error is a failed call. errors.As finds it in the errors of the
+	// clients, to report the request ids to the api provider.
+	Error struct {
+		Call Call
+		Err  error
+	}
+
+	requestIDKey struct{}
+)
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// WithRequestID makes the calls done with ctx send the given request id
+// instead of a generated one, e.g. the id of the incoming request.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
@@ -0,0 +1,57 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestIDFromContext(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		expectedOut string
+	}{
+		{
+			name: "given a context without request id" +
+				"when getting the request id" +
+				"then return empty",
+			ctx: context.Background(),
+		},
+		{
+			name: "given a context with request id" +
+				"when getting the request id" +
+				"then return it",
+			ctx:         WithRequestID(context.Background(), "request_id"),
+			expectedOut: "request_id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := RequestIDFromContext(tt.ctx)
+
+			// Assert
+			assert.Equal(t, tt.expectedOut, got)
+		})
+	}
+}
+
+func TestError(t *testing.T) {
+	// Arrange
+	sentinel := errors.New("not found")
+	err := fmt.Errorf("fetching: %w", &Error{Call: Call{RequestID: "request_id"}, Err: sentinel})
+
+	// Act
+	var callErr *Error
+	found := errors.As(err, &callErr)
+
+	// Assert
+	assert.True(t, found)
+	assert.Equal(t, "request_id", callErr.Call.RequestID)
+	assert.True(t, errors.Is(err, sentinel))
+	assert.EqualError(t, err, "fetching: not found")
+}
@@ -0,0 +1,7 @@
+package metrics
+
+import "errors"
+
+var (
+	ErrRegisterCollector = errors.New("error registering metrics collector")
+)
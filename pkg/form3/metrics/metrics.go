@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	StatusClassError = "error"
+)
+
+type (
+	ICollector interface {
+		ObserveRequestDuration(endpoint string, statusClass string, duration time.Duration)
+		IncRequestsInFlight(endpoint string)
+		DecRequestsInFlight(endpoint string)
+		IncErrors(endpoint string, reason string)
+		IncRetries(endpoint string)
+	}
+
+	NoopCollector struct{}
+)
+
+func (NoopCollector) ObserveRequestDuration(string, string, time.Duration) {}
+
+func (NoopCollector) IncRequestsInFlight(string) {}
+
+func (NoopCollector) DecRequestsInFlight(string) {}
+
+func (NoopCollector) IncErrors(string, string) {}
+
+func (NoopCollector) IncRetries(string) {}
+
+// StatusClass groups http status codes as 2xx, 4xx, etc.
+func StatusClass(statusCode int) string {
+	if statusCode < 100 || statusCode > 599 {
+		return StatusClassError
+	}
+
+	return fmt.Sprintf("%dxx", statusCode/100)
+}
@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusClass(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		expectedOut string
+	}{
+		{
+			name: "given a success status code" +
+				"when getting status class" +
+				"then return 2xx",
+			statusCode:  204,
+			expectedOut: "2xx",
+		},
+		{
+			name: "given a client error status code" +
+				"when getting status class" +
+				"then return 4xx",
+			statusCode:  409,
+			expectedOut: "4xx",
+		},
+		{
+			name: "given an invalid status code" +
+				"when getting status class" +
+				"then return error",
+			statusCode:  0,
+			expectedOut: StatusClassError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := StatusClass(tt.statusCode)
+
+			// Assert
+			assert.Equal(t, tt.expectedOut, got)
+		})
+	}
+}
+
+func TestNewPrometheusCollector(t *testing.T) {
+	// Arrange
+	registry := prometheus.NewRegistry()
+
+	// Act
+	collector, err := NewPrometheusCollector(registry, "form3")
+	require.NoError(t, err)
+
+	collector.ObserveRequestDuration("fetch_account", "2xx", time.Second)
+	collector.IncRequestsInFlight("fetch_account")
+	collector.IncErrors("fetch_account", "not_found")
+	collector.IncErrors("fetch_account", "not_found")
+	collector.IncRetries("fetch_account")
+
+	// Assert
+	c := collector.(prometheusCollector)
+	assert.Equal(t, 1, testutil.CollectAndCount(c.requestDuration))
+	assert.Equal(t, float64(1), testutil.ToFloat64(c.requestsInFlight.WithLabelValues("fetch_account")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(c.errors.WithLabelValues("fetch_account", "not_found")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(c.retries.WithLabelValues("fetch_account")))
+}
+
+func TestNewPrometheusCollector_alreadyRegistered(t *testing.T) {
+	// Arrange
+	registry := prometheus.NewRegistry()
+	_, err := NewPrometheusCollector(registry, "form3")
+	require.NoError(t, err)
+
+	// Act
+	_, err = NewPrometheusCollector(registry, "form3")
+
+	// Assert
+	assert.True(t, errors.Is(err, ErrRegisterCollector))
+}
@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	_labelEndpoint    = "endpoint"
+	_labelStatusClass = "status_class"
+	_labelReason      = "reason"
+)
+
+type prometheusCollector struct {
+	requestDuration  *prometheus.HistogramVec
+	requestsInFlight *prometheus.GaugeVec
+	errors           *prometheus.CounterVec
+	retries          *prometheus.CounterVec
+}
+
+func NewPrometheusCollector(registerer prometheus.Registerer, namespace string) (ICollector, error) {
+	collector := prometheusCollector{
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of requests to the form3 api.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{_labelEndpoint, _labelStatusClass}),
+		requestsInFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "requests_in_flight",
+			Help:      "Number of requests to the form3 api currently in flight.",
+		}, []string{_labelEndpoint}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "errors_total",
+			Help:      "Number of failed form3 api operations by reason.",
+		}, []string{_labelEndpoint, _labelReason}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "retries_total",
+			Help:      "Number of retried requests to the form3 api.",
+		}, []string{_labelEndpoint}),
+	}
+
+	for _, c := range []prometheus.Collector{collector.requestDuration, collector.requestsInFlight, collector.errors, collector.retries} {
+		if err := registerer.Register(c); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrRegisterCollector, err)
+		}
+	}
+
+	return collector, nil
+}
+
+func (c prometheusCollector) ObserveRequestDuration(endpoint string, statusClass string, duration time.Duration) {
+	c.requestDuration.WithLabelValues(endpoint, statusClass).Observe(duration.Seconds())
+}
+
+func (c prometheusCollector) IncRequestsInFlight(endpoint string) {
+	c.requestsInFlight.WithLabelValues(endpoint).Inc()
+}
+
+func (c prometheusCollector) DecRequestsInFlight(endpoint string) {
+	c.requestsInFlight.WithLabelValues(endpoint).Dec()
+}
+
+func (c prometheusCollector) IncErrors(endpoint string, reason string) {
+	c.errors.WithLabelValues(endpoint, reason).Inc()
+}
+
+func (c prometheusCollector) IncRetries(endpoint string) {
+	c.retries.WithLabelValues(endpoint).Inc()
+}
@@ -0,0 +1,13 @@
+package models
+
+const (
+	HealthStatusUp = "up"
+)
+
+type Health struct {
+	Status string `json:"status"`
+}
+
+func (h Health) IsUp() bool {
+	return h.Status == HealthStatusUp
+}
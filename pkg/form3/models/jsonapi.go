@@ -0,0 +1,75 @@
+package models
+
+import "time"
+
+// Document is the {"data": {...}} envelope of a single JSON:API resource.
+type Document[T any] struct {
+	Data  *T     `json:"data"`
+	Links *Links `json:"links,omitempty"`
+}
+
+func (d *Document[T]) WithData(data T) *Document[T] {
+	d.Data = &data
+	return d
+}
+
+// ListDocument is the {"data": [...]} envelope of a page of resources.
+type ListDocument[T any] struct {
+	Data  []T    `json:"data"`
+	Links *Links `json:"links,omitempty"`
+}
+
+func (ld ListDocument[T]) HasNext() bool {
+	return ld.Links != nil && ld.Links.Next != ""
+}
+
+type Links struct {
+	First string `json:"first,omitempty"`
+	Last  string `json:"last,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Self  string `json:"self,omitempty"`
+}
+
+// Resource holds the fields shared by every resource, A being the type of
+// its attributes. CreatedOn and ModifiedOn are set by the api.
+type Resource[A any] struct {
+	Attributes     *A         `json:"attributes,omitempty"`
+	CreatedOn      *time.Time `json:"created_on,omitempty"`
+	ID             string     `json:"id,omitempty"`
+	ModifiedOn     *time.Time `json:"modified_on,omitempty"`
+	OrganisationID string     `json:"organisation_id,omitempty"`
+	Type           string     `json:"type,omitempty"`
+	Version        *int64     `json:"version,omitempty"`
+}
+
+func (r *Resource[A]) WithID(id string) *Resource[A] {
+	r.ID = id
+	return r
+}
+
+func (r *Resource[A]) WithOrganisationID(organisationID string) *Resource[A] {
+	r.OrganisationID = organisationID
+	return r
+}
+
+func (r *Resource[A]) WithType(_type string) *Resource[A] {
+	r.Type = _type
+	return r
+}
+
+func (r *Resource[A]) WithVersion(version int64) *Resource[A] {
+	r.Version = &version
+	return r
+}
+
+func (r *Resource[A]) WithAttributes(attributes A) *Resource[A] {
+	r.Attributes = &attributes
+	return r
+}
+
+// ErrorDocument is the body of the api error responses.
+type ErrorDocument struct {
+	ErrorMessage string `json:"error_message,omitempty"`
+	ErrorCode    string `json:"error_code,omitempty"`
+}
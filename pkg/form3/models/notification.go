@@ -0,0 +1,40 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Notification is the body posted by the api to a subscription callback.
+// Data holds the record that changed, decode it with the typed accessors.
+type Notification struct {
+	ID             string          `json:"id"`
+	OrganisationID string          `json:"organisation_id"`
+	EventType      string          `json:"event_type"`
+	RecordType     string          `json:"record_type"`
+	Version        int64           `json:"version"`
+	Data           json.RawMessage `json:"data"`
+}
+
+func (n Notification) Account() (AccountData, error) {
+	account := AccountData{}
+	return account, n.decode(RecordTypeAccounts, &account)
+}
+
+func (n Notification) Payment() (PaymentData, error) {
+	payment := PaymentData{}
+	return payment, n.decode(RecordTypePayments, &payment)
+}
+
+func (n Notification) PaymentSubmission() (PaymentSubmissionData, error) {
+	submission := PaymentSubmissionData{}
+	return submission, n.decode(RecordTypePaymentSubmissions, &submission)
+}
+
+func (n Notification) decode(recordType string, record interface{}) error {
+	if n.RecordType != recordType {
+		return fmt.Errorf("notification %s is about %s, not %s", n.ID, n.RecordType, recordType)
+	}
+
+	return json.Unmarshal(n.Data, record)
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotification_records(t *testing.T) {
+	// Arrange
+	notification := Notification{}
+	body := `{"id":"n1","event_type":"updated","record_type":"payment_submissions",` +
+		`"data":{"id":"s1","attributes":{"status":"accepted"}}}`
+	require.NoError(t, json.Unmarshal([]byte(body), &notification))
+
+	// Act
+	submission, submissionErr := notification.PaymentSubmission()
+	_, accountErr := notification.Account()
+
+	// Assert
+	require.NoError(t, submissionErr)
+	assert.Equal(t, "s1", submission.ID)
+	assert.Equal(t, "accepted", submission.Attributes.Status)
+	assert.Error(t, accountErr)
+}
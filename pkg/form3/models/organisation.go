@@ -0,0 +1,23 @@
+package models
+
+type (
+	Organisation     = Document[OrganisationData]
+	OrganisationData = Resource[OrganisationAttributes]
+	OrganisationList = ListDocument[OrganisationData]
+)
+
+type OrganisationAttributes struct {
+	Name string `json:"name,omitempty"`
+	// ParentID is the id of the parent organisation unit, if any.
+	ParentID string `json:"parent_id,omitempty"`
+}
+
+func (oa *OrganisationAttributes) WithName(name string) *OrganisationAttributes {
+	oa.Name = name
+	return oa
+}
+
+func (oa *OrganisationAttributes) WithParentID(parentID string) *OrganisationAttributes {
+	oa.ParentID = parentID
+	return oa
+}
@@ -0,0 +1,7 @@
+package models
+
+type (
+	Payment     = Document[PaymentData]
+	PaymentData = Resource[PaymentAttributes]
+	PaymentList = ListDocument[PaymentData]
+)
@@ -0,0 +1,92 @@
+package models
+
+// PaymentAttributes holds the amount as a decimal string, e.g. "10.50", to
+// avoid floating point rounding.
+type PaymentAttributes struct {
+	Amount           string        `json:"amount,omitempty"`
+	Currency         string        `json:"currency,omitempty"`
+	DebtorParty      *PaymentParty `json:"debtor_party,omitempty"`
+	BeneficiaryParty *PaymentParty `json:"beneficiary_party,omitempty"`
+	PaymentScheme    string        `json:"payment_scheme,omitempty"`
+	Reference        string        `json:"reference,omitempty"`
+}
+
+func (pa *PaymentAttributes) WithAmount(amount string) *PaymentAttributes {
+	pa.Amount = amount
+	return pa
+}
+
+func (pa *PaymentAttributes) WithCurrency(currency string) *PaymentAttributes {
+	pa.Currency = currency
+	return pa
+}
+
+func (pa *PaymentAttributes) WithDebtorParty(party PaymentParty) *PaymentAttributes {
+	pa.DebtorParty = &party
+	return pa
+}
+
+func (pa *PaymentAttributes) WithBeneficiaryParty(party PaymentParty) *PaymentAttributes {
+	pa.BeneficiaryParty = &party
+	return pa
+}
+
+func (pa *PaymentAttributes) WithPaymentScheme(paymentScheme string) *PaymentAttributes {
+	pa.PaymentScheme = paymentScheme
+	return pa
+}
+
+func (pa *PaymentAttributes) WithReference(reference string) *PaymentAttributes {
+	pa.Reference = reference
+	return pa
+}
+
+type PaymentParty struct {
+	AccountName       string `json:"account_name,omitempty"`
+	AccountNumber     string `json:"account_number,omitempty"`
+	AccountNumberCode string `json:"account_number_code,omitempty"`
+	BankID            string `json:"bank_id,omitempty"`
+	BankIDCode        string `json:"bank_id_code,omitempty"`
+}
+
+// WithAccount fills the party with the identification of an account.
+func (pp *PaymentParty) WithAccount(account AccountData) *PaymentParty {
+	if account.Attributes == nil {
+		return pp
+	}
+
+	if len(account.Attributes.Name) > 0 {
+		pp.AccountName = account.Attributes.Name[0]
+	}
+
+	pp.BankID = account.Attributes.BankID
+	pp.BankIDCode = account.Attributes.BankIDCode
+
+	switch {
+	case account.Attributes.Iban != "":
+		pp.AccountNumber = account.Attributes.Iban
+		pp.AccountNumberCode = "IBAN"
+	default:
+		pp.AccountNumber = account.Attributes.AccountNumber
+		pp.AccountNumberCode = "BBAN"
+	}
+
+	return pp
+}
+
+func (pp *PaymentParty) WithAccountName(accountName string) *PaymentParty {
+	pp.AccountName = accountName
+	return pp
+}
+
+func (pp *PaymentParty) WithAccountNumber(accountNumber string, accountNumberCode string) *PaymentParty {
+	pp.AccountNumber = accountNumber
+	pp.AccountNumberCode = accountNumberCode
+	return pp
+}
+
+func (pp *PaymentParty) WithBank(bankID string, bankIDCode string) *PaymentParty {
+	pp.BankID = bankID
+	pp.BankIDCode = bankIDCode
+	return pp
+}
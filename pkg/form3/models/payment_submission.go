@@ -0,0 +1,12 @@
+package models
+
+type (
+	PaymentSubmission     = Document[PaymentSubmissionData]
+	PaymentSubmissionData = Resource[PaymentSubmissionAttributes]
+)
+
+// PaymentSubmissionAttributes are set by the api.
+type PaymentSubmissionAttributes struct {
+	Status       string `json:"status,omitempty"`
+	StatusReason string `json:"status_reason,omitempty"`
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentParty_WithAccount(t *testing.T) {
+	tests := []struct {
+		name        string
+		account     AccountData
+		expectedOut PaymentParty
+	}{
+		{
+			name: "given an account with iban" +
+				"when building a party" +
+				"then use the iban as account number",
+			account: *new(AccountData).WithAttributes(*new(AccountAttributes).
+				WithName([]string{"first", "second"}).
+				WithAccountNumber("12345678").
+				WithIban("GB11NWBK40030041426819").
+				WithBankID("400300").
+				WithBankIDCode("GBDSC")),
+			expectedOut: PaymentParty{
+				AccountName:       "first",
+				AccountNumber:     "GB11NWBK40030041426819",
+				AccountNumberCode: "IBAN",
+				BankID:            "400300",
+				BankIDCode:        "GBDSC",
+			},
+		},
+		{
+			name: "given an account without iban" +
+				"when building a party" +
+				"then use the account number",
+			account: *new(AccountData).WithAttributes(*new(AccountAttributes).
+				WithAccountNumber("12345678")),
+			expectedOut: PaymentParty{
+				AccountNumber:     "12345678",
+				AccountNumberCode: "BBAN",
+			},
+		},
+		{
+			name: "given an account without attributes" +
+				"when building a party" +
+				"then return an empty party",
+			account:     AccountData{},
+			expectedOut: PaymentParty{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := new(PaymentParty).WithAccount(tt.account)
+
+			// Assert
+			assert.Equal(t, tt.expectedOut, *got)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package models
+
+const (
+	EventTypeCreated = "created"
+	EventTypeUpdated = "updated"
+	EventTypeDeleted = "deleted"
+
+	RecordTypeAccounts           = "accounts"
+	RecordTypePayments           = "payments"
+	RecordTypePaymentSubmissions = "payment_submissions"
+
+	CallbackTransportHttp = "http"
+)
+
+type (
+	Subscription     = Document[SubscriptionData]
+	SubscriptionData = Resource[SubscriptionAttributes]
+	SubscriptionList = ListDocument[SubscriptionData]
+)
+
+// SubscriptionAttributes subscribes CallbackURI to the EventType
+// notifications of RecordType records.
+type SubscriptionAttributes struct {
+	CallbackTransport string `json:"callback_transport,omitempty"`
+	CallbackURI       string `json:"callback_uri,omitempty"`
+	Deactivated       bool   `json:"deactivated,omitempty"`
+	EventType         string `json:"event_type,omitempty"`
+	RecordType        string `json:"record_type,omitempty"`
+}
+
+func (sa *SubscriptionAttributes) WithCallbackURI(uri string) *SubscriptionAttributes {
+	sa.CallbackTransport = CallbackTransportHttp
+	sa.CallbackURI = uri
+	return sa
+}
+
+func (sa *SubscriptionAttributes) WithDeactivated(deactivated bool) *SubscriptionAttributes {
+	sa.Deactivated = deactivated
+	return sa
+}
+
+func (sa *SubscriptionAttributes) WithEventType(eventType string) *SubscriptionAttributes {
+	sa.EventType = eventType
+	return sa
+}
+
+func (sa *SubscriptionAttributes) WithRecordType(recordType string) *SubscriptionAttributes {
+	sa.RecordType = recordType
+	return sa
+}
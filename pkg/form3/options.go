@@ -0,0 +1,213 @@
+package form3
+
+import (
+	"crypto/x509"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/codec"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/service"
+	"github.com/francorosatti/form3-api-client/pkg/form3/logging"
+	"github.com/francorosatti/form3-api-client/pkg/form3/metrics"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	_defaultTimeout = 3 * time.Second
+)
+
+type (
+	Option func(*clientOptions)
+
+	clientOptions struct {
+		host                  string
+		httpClient            endpoints.IHttpClient
+		timeout               time.Duration
+		retryPolicy           *RetryPolicy
+		rateLimit             *RateLimit
+		bearerToken           string
+		destructiveOperations bool
+		organisationID        string
+		certFile              string
+		keyFile               string
+		rootCAFile            string
+		rootCAs               *x509.CertPool
+		minTLSVersion         uint16
+		reloadCertificate     bool
+		tracerProvider        trace.TracerProvider
+		propagator            propagation.TextMapPropagator
+		metrics               metrics.ICollector
+		logger                *logging.Logger
+		codec                 codec.ICodec
+	}
+)
+
+// WithHost replaces the environment host, e.g. to point the client at a
+// form3test server. The api version is still appended to it.
+func WithHost(host string) Option {
+	return func(options *clientOptions) {
+		options.host = host
+	}
+}
+
+// WithHttpClient replaces the http client used for every request. TLS
+// options are ignored when a custom http client is set.
+func WithHttpClient(httpClient endpoints.IHttpClient) Option {
+	return func(options *clientOptions) {
+		options.httpClient = httpClient
+	}
+}
+
+// WithTimeout limits the time of every request attempt, including reading
+// the response body. It is ignored when a custom http client is set.
+func WithTimeout(timeout time.Duration) Option {
+	return func(options *clientOptions) {
+		options.timeout = timeout
+	}
+}
+
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(options *clientOptions) {
+		options.retryPolicy = &policy
+	}
+}
+
+func WithRateLimit(limit RateLimit) Option {
+	return func(options *clientOptions) {
+		options.rateLimit = &limit
+	}
+}
+
+// WithBearerToken sends the token in the Authorization header of every
+// request.
+func WithBearerToken(token string) Option {
+	return func(options *clientOptions) {
+		options.bearerToken = token
+	}
+}
+
+// WithDestructiveOperations allows operations that delete accounts on
+// production environments, which fail with ErrDestructiveOperationBlocked
+// otherwise.
+func WithDestructiveOperations() Option {
+	return func(options *clientOptions) {
+		options.destructiveOperations = true
+	}
+}
+
+// WithOrganisationID sets the organisation of created accounts that do not
+// have one.
+func WithOrganisationID(organisationID string) Option {
+	return func(options *clientOptions) {
+		options.organisationID = organisationID
+	}
+}
+
+func WithClientCertificate(certFile, keyFile string) Option {
+	return func(options *clientOptions) {
+		options.certFile = certFile
+		options.keyFile = keyFile
+	}
+}
+
+func WithRootCAsFile(caFile string) Option {
+	return func(options *clientOptions) {
+		options.rootCAFile = caFile
+	}
+}
+
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(options *clientOptions) {
+		options.rootCAs = pool
+	}
+}
+
+func WithMinTLSVersion(version uint16) Option {
+	return func(options *clientOptions) {
+		options.minTLSVersion = version
+	}
+}
+
+// WithCertificateReload makes the client pick up a rotated client certificate
+// from disk on the next TLS handshake, without recreating the client.
+func WithCertificateReload() Option {
+	return func(options *clientOptions) {
+		options.reloadCertificate = true
+	}
+}
+
+// WithTracerProvider enables OpenTelemetry spans for every client operation.
+// Tracing is a no-op unless a tracer provider is configured.
+func WithTracerProvider(tracerProvider trace.TracerProvider) Option {
+	return func(options *clientOptions) {
+		options.tracerProvider = tracerProvider
+	}
+}
+
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(options *clientOptions) {
+		options.propagator = propagator
+	}
+}
+
+// WithMetrics records request latency, in-flight requests and errors in the
+// given collector, e.g. metrics.NewPrometheusCollector.
+func WithMetrics(collector metrics.ICollector) Option {
+	return func(options *clientOptions) {
+		options.metrics = collector
+	}
+}
+
+// WithLogger logs every request with the given logger, e.g. a *slog.Logger.
+// Sensitive account attributes are never logged.
+func WithLogger(logger logging.ILogger, opts ...logging.Option) Option {
+	return func(options *clientOptions) {
+		options.logger = logging.New(logger, opts...)
+	}
+}
+
+// WithCodec replaces the codec of the request and response documents, e.g.
+// codec.JSON{DisallowUnknownFields: true} to detect contract changes.
+func WithCodec(codec codec.ICodec) Option {
+	return func(options *clientOptions) {
+		options.codec = codec
+	}
+}
+
+func defaultClientOptions() clientOptions {
+	return clientOptions{
+		timeout: _defaultTimeout,
+	}
+}
+
+// serviceOptions are the options of every resource client.
+func (o clientOptions) serviceOptions(httpClient endpoints.IHttpClient) []service.Option {
+	opts := []service.Option{service.WithHttpClient(httpClient)}
+
+	if o.organisationID != "" {
+		opts = append(opts, service.WithOrganisationID(o.organisationID))
+	}
+
+	if o.tracerProvider != nil {
+		opts = append(opts, service.WithTracerProvider(o.tracerProvider))
+	}
+
+	if o.propagator != nil {
+		opts = append(opts, service.WithPropagator(o.propagator))
+	}
+
+	if o.metrics != nil {
+		opts = append(opts, service.WithMetrics(o.metrics))
+	}
+
+	if o.logger != nil {
+		opts = append(opts, service.WithLogger(o.logger))
+	}
+
+	if o.codec != nil {
+		opts = append(opts, service.WithCodec(o.codec))
+	}
+
+	return opts
+}
@@ -0,0 +1,72 @@
+package form3
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+)
+
+type (
+	// RateLimit limits the requests sent by a client with a token bucket
+	// that refills RequestsPerSecond tokens per second up to Burst tokens.
+	RateLimit struct {
+		RequestsPerSecond float64 `yaml:"requests_per_second"`
+		Burst             int     `yaml:"burst"`
+	}
+
+	rateLimitHttpClient struct {
+		next  endpoints.IHttpClient
+		limit RateLimit
+
+		mu     sync.Mutex
+		tokens float64
+		last   time.Time
+		now    func() time.Time
+	}
+)
+
+func newRateLimitHttpClient(next endpoints.IHttpClient, limit RateLimit) endpoints.IHttpClient {
+	if limit.Burst <= 0 {
+		limit.Burst = 1
+	}
+
+	return &rateLimitHttpClient{
+		next:   next,
+		limit:  limit,
+		tokens: float64(limit.Burst),
+		last:   time.Now(),
+		now:    time.Now,
+	}
+}
+
+func (c *rateLimitHttpClient) Do(req *http.Request) (*http.Response, error) {
+	if err := sleepContext(req, c.reserve()); err != nil {
+		return nil, err
+	}
+
+	return c.next.Do(req)
+}
+
+// reserve takes a token from the bucket and returns how long the caller has
+// to wait until the token is available.
+func (c *rateLimitHttpClient) reserve() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+
+	c.tokens += now.Sub(c.last).Seconds() * c.limit.RequestsPerSecond
+	if c.tokens > float64(c.limit.Burst) {
+		c.tokens = float64(c.limit.Burst)
+	}
+	c.last = now
+
+	c.tokens--
+	if c.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-c.tokens / c.limit.RequestsPerSecond * float64(time.Second))
+}
@@ -0,0 +1,149 @@
+package form3
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/retry"
+)
+
+const (
+	_defaultInitialBackoff = 100 * time.Millisecond
+	_defaultMaxBackoff     = 2 * time.Second
+
+	// _maxDrainSize is how much of a retried response is read to keep its
+	// connection
+	_maxDrainSize = 64 << 10
+)
+
+type (
+	// RetryPolicy is the retry.Policy of a client. Account calls can override
+	// it with accounts.WithRetryPolicy.
+	RetryPolicy = retry.Policy
+
+	retryHttpClient struct {
+		next   endpoints.IHttpClient
+		policy RetryPolicy
+		sleep  func(*http.Request, time.Duration) error
+	}
+)
+
+func newRetryHttpClient(next endpoints.IHttpClient, policy RetryPolicy) endpoints.IHttpClient {
+	return &retryHttpClient{
+		next:   next,
+		policy: withDefaults(policy),
+		sleep:  sleepContext,
+	}
+}
+
+// Do retries with the policy of the request, when it has one, or with the
+// policy of the client.
+func (c *retryHttpClient) Do(req *http.Request) (*http.Response, error) {
+	if !isIdempotent(req) {
+		return endpoints.DoAttempt(c.next, req)
+	}
+
+	policy := c.policy
+	if override, ok := endpoints.RetryPolicyFromContext(req.Context()); ok {
+		policy = withDefaults(override)
+	}
+
+	for attempt := 1; ; attempt++ {
+		res, err := endpoints.DoAttempt(c.next, req)
+		if attempt >= policy.MaxAttempts || !isRetryable(res, err) {
+			return res, err
+		}
+
+		delay := backoff(policy, attempt, res)
+
+		if res != nil {
+			_, _ = io.Copy(ioutil.Discard, io.LimitReader(res.Body, _maxDrainSize))
+			res.Body.Close()
+		}
+
+		if err := c.sleep(req, delay); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// backoff doubles the initial backoff on every attempt, unless the server
+// asks for a specific delay with a Retry-After header in seconds. Either
+// delay is capped at the max backoff.
+func backoff(policy RetryPolicy, attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if seconds, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			if seconds > int(policy.MaxBackoff/time.Second) {
+				return policy.MaxBackoff
+			}
+			return time.Duration(seconds) * time.Second
+		}
+	}
+
+	delay := policy.InitialBackoff << (attempt - 1)
+	if delay <= 0 || delay > policy.MaxBackoff {
+		delay = policy.MaxBackoff
+	}
+
+	return delay
+}
+
+func withDefaults(policy RetryPolicy) RetryPolicy {
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = _defaultInitialBackoff
+	}
+
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = _defaultMaxBackoff
+	}
+
+	return policy
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete:
+		return true
+	case http.MethodPost, http.MethodPatch:
+		return req.Header.Get(endpoints.HeaderIdempotencyKey) != ""
+	default:
+		return false
+	}
+}
+
+func isRetryable(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func sleepContext(req *http.Request, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
@@ -0,0 +1,15 @@
+package retry
+
+import "time"
+
+// Policy retries idempotent requests that fail with a transport error or
+// with a 429, 502, 503 or 504 status code. POST and PATCH requests are
+// idempotent when they carry an idempotency key, which the client sends on
+// every create, update and delete. MaxAttempts counts the first attempt, so
+// a policy with less than two attempts never retries. Delays asked by the
+// server with a Retry-After header are capped at MaxBackoff.
+type Policy struct {
+	MaxAttempts    int           `yaml:"max_attempts"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+}
@@ -0,0 +1,366 @@
+package form3
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/clients/accounts"
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/metadata"
+	"github.com/francorosatti/form3-api-client/pkg/form3/metrics"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func Test_retryHttpClient_Do(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		idempotencyKey   string
+		responses        []int
+		expectedAttempts int
+		expectedStatus   int
+		expectedBackoffs []time.Duration
+	}{
+		{
+			name: "given a server that recovers" +
+				"when getting" +
+				"then retry with exponential backoff",
+			method:           http.MethodGet,
+			responses:        []int{http.StatusServiceUnavailable, 0, http.StatusOK},
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusOK,
+			expectedBackoffs: []time.Duration{100 * time.Millisecond, 200 * time.Millisecond},
+		},
+		{
+			name: "given a server that never recovers" +
+				"when deleting" +
+				"then stop after max attempts",
+			method:           http.MethodDelete,
+			responses:        []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusBadGateway,
+			expectedBackoffs: []time.Duration{100 * time.Millisecond, 200 * time.Millisecond},
+		},
+		{
+			name: "given a client error" +
+				"when getting" +
+				"then do not retry",
+			method:           http.MethodGet,
+			responses:        []int{http.StatusNotFound, http.StatusOK},
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusNotFound,
+		},
+		{
+			name: "given a post request" +
+				"when the server fails" +
+				"then do not retry",
+			method:           http.MethodPost,
+			responses:        []int{http.StatusServiceUnavailable, http.StatusOK},
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusServiceUnavailable,
+		},
+		{
+			name: "given a post request with an idempotency key" +
+				"when the server fails" +
+				"then retry with the same key",
+			method:           http.MethodPost,
+			idempotencyKey:   "key",
+			responses:        []int{http.StatusServiceUnavailable, http.StatusOK},
+			expectedAttempts: 2,
+			expectedStatus:   http.StatusOK,
+			expectedBackoffs: []time.Duration{100 * time.Millisecond},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			attempts := 0
+			next := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, tt.idempotencyKey, req.Header.Get(endpoints.HeaderIdempotencyKey))
+				body, _ := ioutil.ReadAll(req.Body)
+				assert.Equal(t, "body", string(body))
+				status := tt.responses[attempts]
+				attempts++
+				if status == 0 {
+					return nil, errors.New("connection reset")
+				}
+				return &http.Response{StatusCode: status, Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+			})
+
+			var backoffs []time.Duration
+			client := newRetryHttpClient(next, RetryPolicy{MaxAttempts: 3}).(*retryHttpClient)
+			client.sleep = func(_ *http.Request, d time.Duration) error {
+				backoffs = append(backoffs, d)
+				return nil
+			}
+
+			req, err := http.NewRequest(tt.method, "https://host/path", strings.NewReader("body"))
+			require.NoError(t, err)
+			if tt.idempotencyKey != "" {
+				req.Header.Set(endpoints.HeaderIdempotencyKey, tt.idempotencyKey)
+			}
+
+			// Act
+			res, err := client.Do(req)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, res.StatusCode)
+			assert.Equal(t, tt.expectedAttempts, attempts)
+			assert.Equal(t, tt.expectedBackoffs, backoffs)
+		})
+	}
+}
+
+func Test_backoff(t *testing.T) {
+	tests := []struct {
+		name          string
+		attempt       int
+		retryAfter    string
+		expectedDelay time.Duration
+	}{
+		{
+			name: "given no retry after header" +
+				"when computing the backoff" +
+				"then double the initial backoff",
+			attempt:       3,
+			expectedDelay: 400 * time.Millisecond,
+		},
+		{
+			name: "given many attempts" +
+				"when computing the backoff" +
+				"then cap it at the max backoff",
+			attempt:       10,
+			expectedDelay: time.Second,
+		},
+		{
+			name: "given a retry after header" +
+				"when computing the backoff" +
+				"then wait the asked delay",
+			attempt:       1,
+			retryAfter:    "0",
+			expectedDelay: 0,
+		},
+		{
+			name: "given a retry after header longer than the max backoff" +
+				"when computing the backoff" +
+				"then cap it at the max backoff",
+			attempt:       1,
+			retryAfter:    "3600",
+			expectedDelay: time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+			res := &http.Response{Header: http.Header{}}
+			if tt.retryAfter != "" {
+				res.Header.Set("Retry-After", tt.retryAfter)
+			}
+
+			// Act
+			delay := backoff(policy, tt.attempt, res)
+
+			// Assert
+			assert.Equal(t, tt.expectedDelay, delay)
+		})
+	}
+}
+
+func TestNewClient_retriedCreate(t *testing.T) {
+	// Arrange
+	var keys, requestIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(endpoints.HeaderIdempotencyKey))
+		requestIDs = append(requestIDs, r.Header.Get(endpoints.HeaderRequestID))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(EnvironmentLocal,
+		WithHost(server.URL),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}),
+	)
+	require.NoError(t, err)
+
+	var meta metadata.Call
+
+	// Act
+	_, err = client.CreateAccount(*new(models.Account).WithData(*new(models.AccountData).WithID("id")), accounts.WithMetadata(&meta))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, meta.Attempts)
+	assert.Equal(t, http.StatusCreated, meta.StatusCode)
+	assert.Equal(t, []string{keys[0], keys[0]}, keys)
+	assert.Equal(t, []string{meta.RequestID, meta.RequestID}, requestIDs)
+}
+
+type retriesCollector struct {
+	metrics.NoopCollector
+	retries map[string]int
+}
+
+func (c retriesCollector) IncRetries(endpoint string) {
+	c.retries[endpoint]++
+}
+
+func TestNewClient_retriedFetchObservability(t *testing.T) {
+	// Arrange
+	var traceparents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents = append(traceparents, r.Header.Get("traceparent"))
+		if len(traceparents) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"id":"id"}}`))
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	collector := retriesCollector{retries: map[string]int{}}
+
+	client, err := NewClient(EnvironmentLocal,
+		WithHost(server.URL),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}),
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+		WithPropagator(propagation.TraceContext{}),
+		WithMetrics(collector),
+	)
+	require.NoError(t, err)
+
+	// Act
+	_, err = client.FetchAccount("id")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"fetch_account": 1}, collector.retries)
+
+	var attempts []sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Name() == "HTTP GET attempt" {
+			attempts = append(attempts, span)
+		}
+	}
+	require.Len(t, attempts, 2)
+	require.Len(t, traceparents, 2)
+	for i, span := range attempts {
+		assert.Contains(t, span.Attributes(), attribute.Int("http.attempt", i+1))
+		assert.Contains(t, traceparents[i], span.SpanContext().SpanID().String())
+	}
+	assert.Equal(t, codes.Error, attempts[0].Status().Code)
+}
+
+func TestNewClient_accountCallOptions(t *testing.T) {
+	tests := []struct {
+		name             string
+		clientOpts       []Option
+		callOpts         []accounts.CallOption
+		delay            time.Duration
+		expectedAttempts int
+		expectedErr      string
+	}{
+		{
+			name: "given a client without retry policy" +
+				"when fetching with a call retry policy" +
+				"then retry the call",
+			callOpts:         []accounts.CallOption{accounts.WithRetryPolicy(RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond})},
+			expectedAttempts: 2,
+		},
+		{
+			name: "given a client with retry policy" +
+				"when fetching with a call policy without retries" +
+				"then do not retry the call",
+			clientOpts:       []Option{WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})},
+			callOpts:         []accounts.CallOption{accounts.WithRetryPolicy(RetryPolicy{MaxAttempts: 1})},
+			expectedAttempts: 1,
+			expectedErr:      "status code 503",
+		},
+		{
+			name: "given a client with a short timeout" +
+				"when fetching from a slow server with a longer call timeout" +
+				"then return the account",
+			clientOpts:       []Option{WithTimeout(10 * time.Millisecond)},
+			callOpts:         []accounts.CallOption{accounts.WithTimeout(time.Second)},
+			delay:            30 * time.Millisecond,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				time.Sleep(tt.delay)
+				if attempts == 1 && tt.delay == 0 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = w.Write([]byte(`{"data":{"id":"id"}}`))
+			}))
+			defer server.Close()
+
+			client, err := NewClient(EnvironmentLocal, append([]Option{WithHost(server.URL)}, tt.clientOpts...)...)
+			require.NoError(t, err)
+
+			// Act
+			_, err = client.FetchAccount("id", tt.callOpts...)
+
+			// Assert
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+			assert.Equal(t, tt.expectedAttempts, attempts)
+		})
+	}
+}
+
+func Test_rateLimitHttpClient_reserve(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	client := newRateLimitHttpClient(nil, RateLimit{RequestsPerSecond: 2, Burst: 2}).(*rateLimitHttpClient)
+	client.last = now
+	client.now = func() time.Time { return now }
+
+	// Act
+	first, second, third := client.reserve(), client.reserve(), client.reserve()
+
+	now = now.Add(time.Second)
+	afterRefill := client.reserve()
+
+	// Assert
+	assert.Equal(t, time.Duration(0), first)
+	assert.Equal(t, time.Duration(0), second)
+	assert.Equal(t, 500*time.Millisecond, third)
+	assert.Equal(t, time.Duration(0), afterRefill)
+}
@@ -0,0 +1,159 @@
+package form3
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+)
+
+type certificateReloader struct {
+	certFile string
+	keyFile  string
+
+	mu          sync.Mutex
+	certificate *tls.Certificate
+	certModTime time.Time
+	keyModTime  time.Time
+}
+
+func newHttpClient(options clientOptions) (endpoints.IHttpClient, error) {
+	// custom http clients keep their own timeout, unless a call sets one
+	httpClient, timeout := options.httpClient, time.Duration(0)
+	if httpClient == nil {
+		var err error
+		if httpClient, err = newTLSHttpClient(options); err != nil {
+			return nil, err
+		}
+		timeout = options.timeout
+	}
+
+	httpClient = endpoints.NewTimeoutHttpClient(httpClient, timeout)
+
+	if options.bearerToken != "" {
+		httpClient = bearerTokenHttpClient{next: httpClient, token: options.bearerToken}
+	}
+
+	// every retry attempt waits for the rate limiter
+	if options.rateLimit != nil && options.rateLimit.RequestsPerSecond > 0 {
+		httpClient = newRateLimitHttpClient(httpClient, *options.rateLimit)
+	}
+
+	// without a retry policy only the calls that set one are retried
+	policy := RetryPolicy{}
+	if options.retryPolicy != nil {
+		policy = *options.retryPolicy
+	}
+	httpClient = newRetryHttpClient(httpClient, policy)
+
+	return httpClient, nil
+}
+
+func newTLSHttpClient(options clientOptions) (endpoints.IHttpClient, error) {
+	tlsConfig, err := buildTLSConfig(options)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{
+		Transport: transport,
+	}, nil
+}
+
+func buildTLSConfig(options clientOptions) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: options.minTLSVersion,
+	}
+
+	rootCAs := options.rootCAs
+	if options.rootCAFile != "" {
+		pem, err := os.ReadFile(options.rootCAFile)
+		if err != nil {
+			return nil, endpoints.WrapError(ErrLoadRootCAs, err)
+		}
+
+		// the pool of WithRootCAs belongs to the caller and is not modified
+		if rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		} else {
+			rootCAs = rootCAs.Clone()
+		}
+
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%w: no certificates found in %s", ErrLoadRootCAs, options.rootCAFile)
+		}
+	}
+	tlsConfig.RootCAs = rootCAs
+
+	if options.certFile == "" && options.keyFile == "" {
+		return tlsConfig, nil
+	}
+
+	reloader := &certificateReloader{
+		certFile: options.certFile,
+		keyFile:  options.keyFile,
+	}
+
+	if err := reloader.reload(); err != nil {
+		return nil, err
+	}
+
+	if options.reloadCertificate {
+		tlsConfig.GetClientCertificate = reloader.getClientCertificate
+	} else {
+		tlsConfig.Certificates = []tls.Certificate{*reloader.certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+func (r *certificateReloader) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.certificate, nil
+}
+
+func (r *certificateReloader) reload() error {
+	certInfo, err := os.Stat(r.certFile)
+	if err != nil {
+		return endpoints.WrapError(ErrLoadClientCertificate, err)
+	}
+
+	keyInfo, err := os.Stat(r.keyFile)
+	if err != nil {
+		return endpoints.WrapError(ErrLoadClientCertificate, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.certificate != nil &&
+		certInfo.ModTime().Equal(r.certModTime) &&
+		keyInfo.ModTime().Equal(r.keyModTime) {
+		return nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return endpoints.WrapError(ErrLoadClientCertificate, err)
+	}
+
+	r.certificate = &certificate
+	r.certModTime = certInfo.ModTime()
+	r.keyModTime = keyInfo.ModTime()
+
+	return nil
+}
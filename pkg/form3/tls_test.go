@@ -0,0 +1,159 @@
+package form3
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_buildTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCertificate(t, dir, "client")
+	invalidFile := filepath.Join(dir, "invalid.pem")
+	require.NoError(t, os.WriteFile(invalidFile, []byte("invalid"), 0600))
+
+	tests := []struct {
+		name        string
+		options     clientOptions
+		expectedErr error
+	}{
+		{
+			name: "given no tls options" +
+				"when building tls config" +
+				"then return default config",
+			options:     clientOptions{},
+			expectedErr: nil,
+		},
+		{
+			name: "given a valid client certificate and root ca file" +
+				"when building tls config" +
+				"then return config",
+			options: clientOptions{
+				certFile:      certFile,
+				keyFile:       keyFile,
+				rootCAFile:    certFile,
+				minTLSVersion: tls.VersionTLS12,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "given a missing client certificate" +
+				"when building tls config" +
+				"then return error",
+			options: clientOptions{
+				certFile: filepath.Join(dir, "missing.pem"),
+				keyFile:  keyFile,
+			},
+			expectedErr: ErrLoadClientCertificate,
+		},
+		{
+			name: "given an invalid root ca file" +
+				"when building tls config" +
+				"then return error",
+			options: clientOptions{
+				rootCAFile: invalidFile,
+			},
+			expectedErr: ErrLoadRootCAs,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got, err := buildTLSConfig(tt.options)
+
+			// Assert
+			assert.True(t, errors.Is(err, tt.expectedErr))
+			if tt.expectedErr == nil {
+				assert.Equal(t, tt.options.minTLSVersion, got.MinVersion)
+			}
+		})
+	}
+}
+
+func Test_buildTLSConfig_rootCAs(t *testing.T) {
+	// Arrange
+	certFile, _ := writeTestCertificate(t, t.TempDir(), "ca")
+	rootCAs := x509.NewCertPool()
+
+	// Act
+	got, err := buildTLSConfig(clientOptions{rootCAs: rootCAs, rootCAFile: certFile})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, rootCAs.Equal(x509.NewCertPool()), "caller pool is not modified")
+	assert.False(t, got.RootCAs.Equal(x509.NewCertPool()))
+}
+
+func Test_buildTLSConfig_errorCause(t *testing.T) {
+	// Arrange
+	options := clientOptions{rootCAFile: filepath.Join(t.TempDir(), "missing.pem")}
+
+	// Act
+	_, err := buildTLSConfig(options)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrLoadRootCAs)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func Test_certificateReloader_getClientCertificate(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCertificate(t, dir, "first")
+
+	reloader := &certificateReloader{certFile: certFile, keyFile: keyFile}
+	first, err := reloader.getClientCertificate(nil)
+	require.NoError(t, err)
+
+	writeTestCertificate(t, dir, "second")
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, later, later))
+	require.NoError(t, os.Chtimes(keyFile, later, later))
+
+	// Act
+	second, err := reloader.getClientCertificate(nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Certificate[0], second.Certificate[0])
+}
+
+func writeTestCertificate(t *testing.T, dir, commonName string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	return certFile, keyFile
+}
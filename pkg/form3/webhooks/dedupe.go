@@ -0,0 +1,69 @@
+package webhooks
+
+import "sync"
+
+// deduplicator remembers the last capacity notification ids. An id is
+// claimed while its notification is handled, then either completed or
+// released if handling fails, so that the redelivery is handled again.
+type deduplicator struct {
+	mu       sync.Mutex
+	capacity int
+	// seen tells whether the notification of every claimed id was handled
+	seen  map[string]bool
+	order []string
+}
+
+func newDeduplicator(capacity int) *deduplicator {
+	return &deduplicator{
+		capacity: capacity,
+		seen:     make(map[string]bool),
+	}
+}
+
+// claim returns false when id was already claimed, along with whether its
+// notification was handled or is still being handled.
+func (d *deduplicator) claim(id string) (claimed bool, handled bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if handled, exists := d.seen[id]; exists {
+		return false, handled
+	}
+
+	d.seen[id] = false
+	d.order = append(d.order, id)
+
+	for len(d.order) > d.capacity {
+		delete(d.seen, d.order[0])
+		d.order = d.order[1:]
+	}
+
+	return true, false
+}
+
+func (d *deduplicator) complete(id string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, exists := d.seen[id]; exists {
+		d.seen[id] = true
+	}
+}
+
+func (d *deduplicator) release(id string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, exists := d.seen[id]; !exists {
+		return
+	}
+
+	delete(d.seen, id)
+
+	for i, claimed := range d.order {
+		if claimed == id {
+			d.order = append(d.order[:i], d.order[i+1:]...)
+			break
+		}
+	}
+}
@@ -0,0 +1,8 @@
+package webhooks
+
+import "errors"
+
+var (
+	ErrInvalidSignature    = errors.New("invalid notification signature")
+	ErrInvalidNotification = errors.New("invalid notification")
+)
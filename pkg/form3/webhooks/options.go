@@ -0,0 +1,39 @@
+package webhooks
+
+const (
+	_defaultDedupeCapacity = 10000
+	_defaultMaxBodySize    = 1 << 20
+)
+
+type (
+	Option func(*options)
+
+	options struct {
+		dedupeCapacity int
+		maxBodySize    int64
+	}
+)
+
+// WithDedupeCapacity sets how many notification ids are remembered to
+// discard redeliveries. The oldest ids are forgotten first. Non-positive
+// capacities keep the default.
+func WithDedupeCapacity(capacity int) Option {
+	return func(options *options) {
+		if capacity > 0 {
+			options.dedupeCapacity = capacity
+		}
+	}
+}
+
+func WithMaxBodySize(size int64) Option {
+	return func(options *options) {
+		options.maxBodySize = size
+	}
+}
+
+func defaultOptions() options {
+	return options{
+		dedupeCapacity: _defaultDedupeCapacity,
+		maxBodySize:    _defaultMaxBodySize,
+	}
+}
@@ -0,0 +1,127 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/internal/endpoints"
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+)
+
+// Handler processes a notification. Returning an error answers the delivery
+// with a 500 so that the api delivers the notification again.
+type Handler func(ctx context.Context, notification models.Notification) error
+
+// Receiver is the http.Handler of a subscription callback uri. It verifies
+// the signature of every notification, discards redeliveries of already
+// handled notifications and dispatches the rest by event type. Redeliveries
+// of notifications still being handled are answered with a 409, so that the
+// api delivers them again if handling fails.
+// Notifications without a registered handler are acknowledged and dropped.
+type Receiver struct {
+	secret      []byte
+	maxBodySize int64
+	dedupe      *deduplicator
+
+	mu       sync.RWMutex
+	handlers map[string]Handler
+}
+
+func NewReceiver(secret []byte, opts ...Option) *Receiver {
+	options := defaultOptions()
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return &Receiver{
+		secret:      secret,
+		maxBodySize: options.maxBodySize,
+		dedupe:      newDeduplicator(options.dedupeCapacity),
+		handlers:    make(map[string]Handler),
+	}
+}
+
+// Handle registers the handler of the notifications of eventType, replacing
+// any previous one. Use Notification.RecordType to tell records apart.
+func (r *Receiver) Handle(eventType string, handler Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.handlers[eventType] = handler
+}
+
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	notification, err := r.decode(w, req)
+	switch {
+	case errors.Is(err, ErrInvalidSignature):
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	claimed, handled := r.dedupe.claim(notification.ID)
+	switch {
+	case handled:
+		w.WriteHeader(http.StatusOK)
+		return
+	case !claimed:
+		http.Error(w, "notification is being handled", http.StatusConflict)
+		return
+	}
+
+	if err = r.dispatch(req.Context(), notification); err != nil {
+		r.dedupe.release(notification.ID)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	r.dedupe.complete(notification.ID)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *Receiver) decode(w http.ResponseWriter, req *http.Request) (models.Notification, error) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, req.Body, r.maxBodySize))
+	if err != nil {
+		return models.Notification{}, endpoints.WrapError(ErrInvalidNotification, err)
+	}
+
+	if err = verify(r.secret, body, req.Header.Get(SignatureHeader)); err != nil {
+		return models.Notification{}, err
+	}
+
+	notification := models.Notification{}
+	if err = json.Unmarshal(body, &notification); err != nil {
+		return models.Notification{}, endpoints.WrapError(ErrInvalidNotification, err)
+	}
+
+	if notification.ID == "" || notification.EventType == "" {
+		return models.Notification{}, fmt.Errorf("%w: id and event_type are required", ErrInvalidNotification)
+	}
+
+	return notification, nil
+}
+
+func (r *Receiver) dispatch(ctx context.Context, notification models.Notification) error {
+	r.mu.RLock()
+	handler, exists := r.handlers[notification.EventType]
+	r.mu.RUnlock()
+
+	if !exists {
+		return nil
+	}
+
+	return handler(ctx, notification)
+}
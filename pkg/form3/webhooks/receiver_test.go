@@ -0,0 +1,260 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _secret = []byte("secret")
+
+func newNotificationRequest(body string, signature string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	req.Header.Set(SignatureHeader, signature)
+
+	return req
+}
+
+func TestReceiver_ServeHTTP(t *testing.T) {
+	body := `{"id":"n1","event_type":"updated","record_type":"payments","data":{"id":"p1"}}`
+
+	tests := []struct {
+		name               string
+		method             string
+		body               string
+		signature          string
+		handlerErr         error
+		expectedStatusCode int
+		expectedHandled    int
+	}{
+		{
+			name: "given a signed notification" +
+				"when receiving it" +
+				"then dispatch it to the event type handler",
+			body:               body,
+			signature:          Sign(_secret, []byte(body)),
+			expectedStatusCode: http.StatusOK,
+			expectedHandled:    1,
+		},
+		{
+			name: "given a notification signed with another secret" +
+				"when receiving it" +
+				"then respond unauthorized",
+			body:               body,
+			signature:          Sign([]byte("other"), []byte(body)),
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "given a notification without signature" +
+				"when receiving it" +
+				"then respond unauthorized",
+			body:               body,
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "given a signed notification without id" +
+				"when receiving it" +
+				"then respond bad request",
+			body:               `{"event_type":"updated"}`,
+			signature:          Sign(_secret, []byte(`{"event_type":"updated"}`)),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "given a signed notification" +
+				"when the handler fails" +
+				"then respond internal server error",
+			body:               body,
+			signature:          Sign(_secret, []byte(body)),
+			handlerErr:         errors.New("handler error"),
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedHandled:    1,
+		},
+		{
+			name: "given a get request" +
+				"when receiving it" +
+				"then respond method not allowed",
+			method:             http.MethodGet,
+			expectedStatusCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			handled := 0
+			receiver := NewReceiver(_secret)
+			receiver.Handle(models.EventTypeUpdated, func(_ context.Context, notification models.Notification) error {
+				handled++
+				payment, err := notification.Payment()
+				require.NoError(t, err)
+				assert.Equal(t, "p1", payment.ID)
+				return tt.handlerErr
+			})
+
+			req := newNotificationRequest(tt.body, tt.signature)
+			if tt.method != "" {
+				req.Method = tt.method
+			}
+
+			recorder := httptest.NewRecorder()
+
+			// Act
+			receiver.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatusCode, recorder.Code)
+			assert.Equal(t, tt.expectedHandled, handled)
+		})
+	}
+}
+
+func TestReceiver_ServeHTTP_redeliveries(t *testing.T) {
+	// Arrange
+	body := `{"id":"n1","event_type":"created","record_type":"accounts","data":{"id":"a1"}}`
+	fail := true
+	handled := 0
+
+	receiver := NewReceiver(_secret)
+	receiver.Handle(models.EventTypeCreated, func(context.Context, models.Notification) error {
+		handled++
+		if fail {
+			return errors.New("handler error")
+		}
+		return nil
+	})
+
+	deliver := func() int {
+		recorder := httptest.NewRecorder()
+		receiver.ServeHTTP(recorder, newNotificationRequest(body, Sign(_secret, []byte(body))))
+		return recorder.Code
+	}
+
+	// Act & Assert
+	assert.Equal(t, http.StatusInternalServerError, deliver())
+
+	fail = false
+	assert.Equal(t, http.StatusOK, deliver())
+	assert.Equal(t, http.StatusOK, deliver())
+	assert.Equal(t, 2, handled)
+}
+
+func TestReceiver_ServeHTTP_inFlightRedelivery(t *testing.T) {
+	// Arrange
+	body := `{"id":"n1","event_type":"created","record_type":"accounts","data":{"id":"a1"}}`
+	started, release := make(chan struct{}), make(chan struct{})
+	handled := 0
+
+	receiver := NewReceiver(_secret)
+	receiver.Handle(models.EventTypeCreated, func(context.Context, models.Notification) error {
+		handled++
+		close(started)
+		<-release
+		return nil
+	})
+
+	deliver := func() int {
+		recorder := httptest.NewRecorder()
+		receiver.ServeHTTP(recorder, newNotificationRequest(body, Sign(_secret, []byte(body))))
+		return recorder.Code
+	}
+
+	first := make(chan int)
+	go func() { first <- deliver() }()
+	<-started
+
+	// Act & Assert
+	assert.Equal(t, http.StatusConflict, deliver())
+
+	close(release)
+	assert.Equal(t, http.StatusOK, <-first)
+	assert.Equal(t, http.StatusOK, deliver())
+	assert.Equal(t, 1, handled)
+}
+
+func TestReceiver_ServeHTTP_dedupeCapacity(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+	}{
+		{
+			name: "given a zero dedupe capacity" +
+				"when a notification is redelivered" +
+				"then discard the redelivery",
+			capacity: 0,
+		},
+		{
+			name: "given a negative dedupe capacity" +
+				"when a notification is redelivered" +
+				"then discard the redelivery",
+			capacity: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			body := `{"id":"n1","event_type":"created","record_type":"accounts","data":{"id":"a1"}}`
+			handled := 0
+
+			receiver := NewReceiver(_secret, WithDedupeCapacity(tt.capacity))
+			receiver.Handle(models.EventTypeCreated, func(context.Context, models.Notification) error {
+				handled++
+				return nil
+			})
+
+			deliver := func() int {
+				recorder := httptest.NewRecorder()
+				receiver.ServeHTTP(recorder, newNotificationRequest(body, Sign(_secret, []byte(body))))
+				return recorder.Code
+			}
+
+			// Act & Assert
+			assert.Equal(t, http.StatusOK, deliver())
+			assert.Equal(t, http.StatusOK, deliver())
+			assert.Equal(t, 1, handled)
+		})
+	}
+}
+
+func TestReceiver_ServeHTTP_unhandledEventType(t *testing.T) {
+	// Arrange
+	body := `{"id":"n1","event_type":"deleted","record_type":"accounts"}`
+	recorder := httptest.NewRecorder()
+
+	// Act
+	NewReceiver(_secret).ServeHTTP(recorder, newNotificationRequest(body, Sign(_secret, []byte(body))))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_deduplicator(t *testing.T) {
+	// Arrange
+	dedupe := newDeduplicator(2)
+
+	claim := func(id string) []bool {
+		claimed, handled := dedupe.claim(id)
+		return []bool{claimed, handled}
+	}
+
+	// Act & Assert
+	assert.Equal(t, []bool{true, false}, claim("a"))
+	assert.Equal(t, []bool{false, false}, claim("a"), "id is being handled")
+
+	dedupe.complete("a")
+	assert.Equal(t, []bool{false, true}, claim("a"), "id was handled")
+
+	assert.Equal(t, []bool{true, false}, claim("b"))
+	assert.Equal(t, []bool{true, false}, claim("c"))
+	assert.Equal(t, []bool{true, false}, claim("a"), "oldest id is forgotten")
+
+	dedupe.release("c")
+	assert.Equal(t, []bool{true, false}, claim("c"))
+}
@@ -0,0 +1,35 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	SignatureHeader = "X-Form3-Signature"
+
+	_signaturePrefix = "sha256="
+)
+
+// Sign returns the SignatureHeader value of body: the hex encoded
+// HMAC-SHA256 of the body keyed with the subscription secret.
+func Sign(secret []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return _signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func verify(secret []byte, body []byte, signature string) error {
+	if !strings.HasPrefix(signature, _signaturePrefix) {
+		return ErrInvalidSignature
+	}
+
+	if !hmac.Equal([]byte(Sign(secret, body)), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
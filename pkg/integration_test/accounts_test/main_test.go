@@ -0,0 +1,124 @@
+package account_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/francorosatti/form3-api-client/pkg/form3"
+	"github.com/francorosatti/form3-api-client/pkg/form3/form3test"
+	"github.com/google/uuid"
+)
+
+const (
+	_envRecorderMode = "FORM3_VCR_MODE"
+	_cassettePath    = "testdata/cassettes/accounts.json"
+
+	_readyTimeout  = 30 * time.Second
+	_readyInterval = 500 * time.Millisecond
+	_dialTimeout   = time.Second
+)
+
+var (
+	_recorder *form3test.Recorder
+)
+
+// TestMain runs the integration tests against the local api by default, or
+// from the cassette when nothing listens on the local api address. Set
+// FORM3_VCR_MODE=record to record a cassette against a fresh local api, or
+// FORM3_VCR_MODE=replay to run the tests from the cassette without containers.
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	mode := os.Getenv(_envRecorderMode)
+	if mode == "" && !listening() {
+		fmt.Fprintf(os.Stderr, "local api is not running, replaying %s\n", _cassettePath)
+		mode = "replay"
+	}
+
+	// the local api takes a while to start, replayed tests do not need it
+	if mode != "replay" {
+		if err := waitUntilReady(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+	}
+
+	switch mode {
+	case "":
+		return m.Run()
+	case "record", "replay":
+		// deterministic ids make recorded requests match when replayed
+		uuid.SetRand(rand.New(rand.NewSource(1)))
+	default:
+		fmt.Fprintf(os.Stderr, "unknown %s %q\n", _envRecorderMode, mode)
+		return 1
+	}
+
+	recorderMode := form3test.ModeReplay
+	if mode == "record" {
+		recorderMode = form3test.ModeRecord
+	}
+
+	var err error
+	_recorder, err = form3test.NewRecorder(_cassettePath, recorderMode)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	code := m.Run()
+
+	if err = _recorder.Save(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	return code
+}
+
+func newClient() (form3.IClient, error) {
+	if _recorder == nil {
+		return form3.NewClient(form3.EnvironmentLocal)
+	}
+
+	return form3.NewClient(form3.EnvironmentLocal, form3.WithHttpClient(_recorder))
+}
+
+func waitUntilReady() error {
+	client, err := form3.NewClient(form3.EnvironmentLocal)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), _readyTimeout)
+	defer cancel()
+
+	return client.WaitUntilReady(ctx, _readyInterval)
+}
+
+// listening reports whether something accepts connections on the local api
+// address, even if the api is still starting.
+func listening() bool {
+	config, _ := form3.LookupEnvironment(form3.EnvironmentLocal)
+
+	u, err := url.Parse(config.Host)
+	if err != nil {
+		return false
+	}
+
+	conn, err := net.DialTimeout("tcp", u.Host, _dialTimeout)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+
+	return true
+}